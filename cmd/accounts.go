@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+)
+
+const accountsUsage = "usage: accounts role <email> <admin|organiser|player>"
+
+// runAccounts executa o subcomando `accounts`. Ele fala direto com o banco, sem passar pela
+// política da API: é assim que o primeiro admin é promovido.
+func runAccounts(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) != 3 || args[0] != "role" {
+		return errors.New(accountsUsage)
+	}
+	role := domain.Role(args[2])
+	if err := role.Validate(); err != nil {
+		return errors.New(accountsUsage)
+	}
+
+	db, err := database.NewDatabase(database.NewConfig())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := repositories.NewAccount(db, slog.Default())
+	account, err := repo.GetAccountByEmail(ctx, domain.NormalizeEmail(args[1]))
+	if err != nil {
+		return err
+	}
+	if account, err = repo.SetAccountRole(ctx, account.ID, role); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "account %d (%s) is now %s\n", account.ID, account.Email, account.Role)
+	return nil
+}
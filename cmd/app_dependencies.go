@@ -3,8 +3,11 @@ package main
 import (
 	"log/slog"
 
+	"fut-app/internal/auth"
 	"fut-app/internal/database/gateway"
 	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/metrics"
 	"fut-app/internal/usecase"
 
 	"fut-app/internal/database"
@@ -12,14 +15,89 @@ import (
 
 type Dependencies struct {
 	usecase.RegisterPlayerUseCase
+	GetPlayer        usecase.GetPlayerUseCase
+	ListPlayers      usecase.ListPlayersUseCase
+	UpdatePlayer     usecase.UpdatePlayerUseCase
+	DeletePlayer     usecase.DeletePlayerUseCase
+	GetPlayerCard    usecase.GetPlayerCardUseCase
+	MigrateStats     usecase.MigratePlayerStatsUseCase
+	CreatePosition   usecase.CreatePositionUseCase
+	ListPositions    usecase.ListPositionsUseCase
+	UpdatePosition   usecase.UpdatePositionUseCase
+	DeletePosition   usecase.DeletePositionUseCase
+	SeedPositions    usecase.SeedPositionsUseCase
+	CreateMatch      usecase.CreateMatchUseCase
+	GetMatch         usecase.GetMatchUseCase
+	ListMatches      usecase.ListMatchesUseCase
+	UpdateMatch      usecase.UpdateMatchUseCase
+	DrawTeams        usecase.DrawTeamsUseCase
+	RSVP             usecase.RSVPUseCase
+	GetRoster        usecase.GetRosterUseCase
+	RecordNoShows    usecase.RecordNoShowsUseCase
+	SubmitRatings    usecase.SubmitRatingsUseCase
+	RegisterAccount  usecase.RegisterAccountUseCase
+	Login            usecase.LoginUseCase
+	RefreshToken     usecase.RefreshTokenUseCase
+	Logout           usecase.LogoutUseCase
+	Authenticate     usecase.AuthenticateUseCase
+	ChangeRole       usecase.ChangeAccountRoleUseCase
+	CreateGroup      usecase.CreateGroupUseCase
+	ListGroups       usecase.ListGroupsUseCase
+	JoinGroup        usecase.JoinGroupUseCase
+	GetMembership    usecase.GetMembershipUseCase
+	ListMembers      usecase.ListMembersUseCase
+	RotateJoinCode   usecase.RotateJoinCodeUseCase
+	ChangeMemberRole usecase.ChangeMemberRoleUseCase
 }
 
-func InjectDependencies(db *database.Database, logger *slog.Logger) Dependencies {
-	repo := repositories.NewPlayer(db.DB, logger)
+func InjectDependencies(db *database.Database, logger *slog.Logger, m *metrics.Metrics, authConfig auth.Config) Dependencies {
+	repo := repositories.NewPlayer(db, logger)
 	rg := gateway.NewRegisterPlayerGateway(repo)
-	p := usecase.NewPlayerUseCase(rg)
+	p := m.RegisterPlayer(usecase.NewPlayerUseCase(rg))
+	positionRepo := repositories.NewPosition(db.DB, logger)
+	matchRepo := repositories.NewMatch(db, logger)
+	ratingRepo := repositories.NewRating(db, logger)
+	attendanceRepo := repositories.NewAttendance(db, logger)
+	engine := domain.NewRatingEngine()
+	cards := usecase.NewRecomputePlayerCardsUseCase(gateway.NewRecomputePlayerCardsGateway(repo, ratingRepo), engine)
+	accountRepo := repositories.NewAccount(db, logger)
+	tokens := auth.NewTokens(authConfig)
+	hasher := auth.NewBcryptHasher(authConfig.BcryptCost)
+	groupRepo := repositories.NewGroup(db, logger)
 
 	return Dependencies{
 		RegisterPlayerUseCase: p,
+		GetPlayer:             usecase.NewGetPlayerUseCase(gateway.NewGetPlayerGateway(repo)),
+		ListPlayers:           usecase.NewListPlayersUseCase(gateway.NewListPlayersGateway(repo)),
+		UpdatePlayer:          usecase.NewUpdatePlayerUseCase(gateway.NewUpdatePlayerGateway(repo)),
+		DeletePlayer:          usecase.NewDeletePlayerUseCase(gateway.NewDeletePlayerGateway(repo)),
+		GetPlayerCard:         usecase.NewGetPlayerCardUseCase(gateway.NewGetPlayerCardGateway(repo, ratingRepo, attendanceRepo), engine),
+		CreatePosition:        usecase.NewCreatePositionUseCase(gateway.NewCreatePositionGateway(positionRepo)),
+		ListPositions:         usecase.NewListPositionsUseCase(gateway.NewListPositionsGateway(positionRepo)),
+		UpdatePosition:        usecase.NewUpdatePositionUseCase(gateway.NewUpdatePositionGateway(positionRepo)),
+		DeletePosition:        usecase.NewDeletePositionUseCase(gateway.NewDeletePositionGateway(positionRepo)),
+		SeedPositions:         usecase.NewSeedPositionsUseCase(gateway.NewSeedPositionsGateway(positionRepo)),
+		CreateMatch:           usecase.NewCreateMatchUseCase(gateway.NewCreateMatchGateway(matchRepo)),
+		GetMatch:              usecase.NewGetMatchUseCase(gateway.NewGetMatchGateway(matchRepo)),
+		ListMatches:           usecase.NewListMatchesUseCase(gateway.NewListMatchesGateway(matchRepo)),
+		UpdateMatch:           usecase.NewUpdateMatchUseCase(gateway.NewUpdateMatchGateway(matchRepo), m),
+		DrawTeams:             usecase.NewDrawTeamsUseCase(gateway.NewDrawTeamsGateway(matchRepo, attendanceRepo, repo, positionRepo), engine),
+		RSVP:                  usecase.NewRSVPUseCase(gateway.NewRSVPGateway(matchRepo, attendanceRepo)),
+		GetRoster:             usecase.NewGetRosterUseCase(gateway.NewGetRosterGateway(attendanceRepo)),
+		RecordNoShows:         usecase.NewRecordNoShowsUseCase(gateway.NewRecordNoShowsGateway(matchRepo, attendanceRepo)),
+		SubmitRatings:         m.SubmitRatings(usecase.NewSubmitRatingsUseCase(gateway.NewSubmitRatingsGateway(matchRepo, ratingRepo), cards)),
+		RegisterAccount:       usecase.NewRegisterAccountUseCase(gateway.NewRegisterAccountGateway(accountRepo), hasher),
+		Login:                 usecase.NewLoginUseCase(gateway.NewLoginGateway(accountRepo), hasher, tokens),
+		RefreshToken:          usecase.NewRefreshTokenUseCase(gateway.NewRefreshTokenGateway(accountRepo), tokens),
+		Logout:                usecase.NewLogoutUseCase(gateway.NewLogoutGateway(accountRepo), tokens),
+		Authenticate:          usecase.NewAuthenticateUseCase(gateway.NewAuthenticateGateway(accountRepo), tokens),
+		ChangeRole:            usecase.NewChangeAccountRoleUseCase(gateway.NewChangeAccountRoleGateway(accountRepo)),
+		CreateGroup:           usecase.NewCreateGroupUseCase(gateway.NewCreateGroupGateway(groupRepo)),
+		ListGroups:            usecase.NewListGroupsUseCase(gateway.NewListGroupsGateway(groupRepo)),
+		JoinGroup:             usecase.NewJoinGroupUseCase(gateway.NewJoinGroupGateway(groupRepo)),
+		GetMembership:         usecase.NewGetMembershipUseCase(gateway.NewGetMembershipGateway(groupRepo)),
+		ListMembers:           usecase.NewListMembersUseCase(gateway.NewListMembersGateway(groupRepo)),
+		RotateJoinCode:        usecase.NewRotateJoinCodeUseCase(gateway.NewRotateJoinCodeGateway(groupRepo)),
+		ChangeMemberRole:      usecase.NewChangeMemberRoleUseCase(gateway.NewChangeMemberRoleGateway(groupRepo)),
 	}
 }
@@ -1,18 +1,34 @@
 package main
 
 import (
+	"context"
 	"log/slog"
-	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"fut-app/pkg/logger"
+	"fut-app/pkg/tracing"
 
 	"github.com/gorilla/mux"
 	"github.com/joho/godotenv"
 
+	"fut-app/internal/auth"
 	"fut-app/internal/database"
-	"fut-app/internal/database/models"
+	"fut-app/internal/database/migrations"
+	"fut-app/internal/handlers/middleware"
+	"fut-app/internal/health"
+	"fut-app/internal/metrics"
+	"fut-app/internal/server"
+)
+
+const (
+	// healthCheckTimeout limita cada verificação de /health/ready.
+	healthCheckTimeout = 2 * time.Second
+	// traceFlushTimeout limita o envio dos spans pendentes no encerramento.
+	traceFlushTimeout = 5 * time.Second
 )
 
 func loadEnv() {
@@ -31,24 +47,81 @@ func loadEnv() {
 
 func main() {
 	loadEnv()
-	logger := logger.NewLogger(logger.Config{
-		AppName: "fut-app",
-	})
+	logger := logger.NewLogger(logger.ConfigFromEnv("fut-app"))
 	slog.SetDefault(logger)
-	db := createDatabase()
-	d := InjectDependencies(db, logger)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			slog.Error("❌ Migration failed", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "accounts" {
+		if err := runAccounts(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			slog.Error("❌ Account command failed", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv("fut-app"))
+	if err != nil {
+		slog.Error("❌ Failed to set up tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	authConfig := auth.NewConfig()
+	if err := authConfig.Validate(); err != nil {
+		slog.Error("❌ Invalid auth configuration", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	db, migrator := createDatabase()
+	m := metrics.New()
+	if err := m.RegisterDatabase(db, db.Dialector.Name()); err != nil {
+		slog.Error("❌ Failed to register database metrics", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	d := InjectDependencies(db, logger, m, authConfig)
+	if err := d.SeedPositions.Execute(context.Background()); err != nil {
+		slog.Error("❌ Failed to seed positions", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// O primeiro SIGINT/SIGTERM inicia o drain; um segundo sinal encerra na hora.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	context.AfterFunc(ctx, stop)
+
 	r := mux.NewRouter()
-	CreateRoutes(r, d)
+	r.Use(middleware.Tracing("fut-app"))
+	handler := middleware.RequestID(middleware.AccessLog(logger, r)(middleware.Metrics(m, r)(r)))
+	srv := server.New(server.NewConfig(), handler, logger)
+
+	checks := health.NewRegistry(healthCheckTimeout)
+	checks.Register("database", true, db.HealthCheck)
+	checks.Register("migrations", true, migrator.HealthCheck)
+	checks.Register("http", true, srv.HealthCheck)
+	CreateRoutes(r, d, checks, m.Handler())
 
-	slog.Info("🚀 Server is running on port 8080")
 	slog.Info("It's time ⚽ ⚽ ⚽ ⚽ ⚽ ⚽")
-	if err := http.ListenAndServe(":8080", r); err != nil {
-		slog.Error("Error starting server", slog.String("error", err.Error()))
+	err = srv.Run(ctx)
+	if closeErr := db.Close(); closeErr != nil {
+		slog.Error("❌ Failed to close the database", slog.String("error", closeErr.Error()))
+	}
+	// ctx já foi cancelado pelo sinal; os spans pendentes têm um prazo próprio.
+	flushCtx, cancel := context.WithTimeout(context.Background(), traceFlushTimeout)
+	if flushErr := shutdownTracing(flushCtx); flushErr != nil {
+		slog.Error("❌ Failed to flush traces", slog.String("error", flushErr.Error()))
+	}
+	cancel()
+	if err != nil {
+		slog.Error("Error running server", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
 }
 
-func createDatabase() *database.Database {
+func createDatabase() (*database.Database, *migrations.Migrator) {
 	config := database.NewConfig()
 	db, err := database.NewDatabase(config)
 	if err != nil {
@@ -58,10 +131,13 @@ func createDatabase() *database.Database {
 
 	slog.Info("✅ Successfully connected to the database!")
 
-	err = db.AutoMigrate(&models.Player{}, &models.Position{}, &models.Match{}, &models.Rating{})
+	migrator, err := newMigrator(db)
+	if err == nil {
+		err = migrator.CheckCurrent(context.Background())
+	}
 	if err != nil {
-		slog.Error("❌ Error in auto migrate", slog.String("error", err.Error()))
+		slog.Error("❌ Database schema is not current, run `migrate up` first", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
-	return db
+	return db, migrator
 }
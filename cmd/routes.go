@@ -1,41 +1,229 @@
 package main
 
 import (
-	"fmt"
 	"net/http"
+	"time"
 
 	"fut-app/internal/handlers/dto"
 	"fut-app/internal/handlers/middleware"
 
 	"fut-app/internal/handlers"
+	"fut-app/internal/health"
 
 	"github.com/gorilla/mux"
 )
 
-func CreateRoutes(r *mux.Router, d Dependencies) { // TODO criar app dependency e remover repositories daqui.
-	r.HandleFunc("/health", HealthCheckHandler).Methods(http.MethodGet)
-	players(r, d)
+const (
+	// requestTimeout vale para as rotas comuns; migrateTimeout é da migração de stats,
+	// que percorre todos os jogadores.
+	requestTimeout = 5 * time.Second
+	migrateTimeout = 2 * time.Minute
+)
+
+var (
+	withTimeout     = middleware.Timeout(requestTimeout)
+	withLongTimeout = middleware.Timeout(migrateTimeout)
+)
+
+func CreateRoutes(r *mux.Router, d Dependencies, checks *health.Registry, metrics http.Handler) { // TODO criar app dependency e remover repositories daqui.
+	healthChecks(r, checks)
+	r.Handle("/metrics", metrics).Methods(http.MethodGet)
+
+	// Health, métricas e /auth (menos o logout) são públicas; todo o resto passa pelo
+	// subrouter que exige o token de acesso.
+	authenticated := middleware.Authenticate(d.Authenticate)
+	authRoutes(r, d, authenticated)
+
+	api := r.NewRoute().Subrouter()
+	api.Use(authenticated)
+	groups(api, d)
+	positions(api, d)
+	playerStats(api, d)
+	accounts(api, d)
+
+	// Jogadores, partidas e notas existem só dentro de um grupo: as rotas deles ficam sob
+	// /groups/{group}, que resolve o vínculo da conta antes do handler.
+	group := api.PathPrefix("/groups/{group:[0-9]+}").Subrouter()
+	group.Use(middleware.GroupScope(d.GetMembership))
+	members(group, d)
+	players(group, d)
+	matches(group, d)
+	attendance(group, d)
+	ratings(group, d)
+}
+
+func authRoutes(r *mux.Router, d Dependencies, authenticated func(http.Handler) http.Handler) {
+	authHandler := handlers.NewAuthHandler(d.RegisterAccount, d.Login, d.RefreshToken, d.Logout)
+
+	r.Handle("/auth/register",
+		withTimeout(middleware.ValidateJSON[dto.RegisterDTO](authHandler.Register)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/auth/login",
+		withTimeout(middleware.ValidateJSON[dto.LoginDTO](authHandler.Login)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/auth/refresh",
+		withTimeout(middleware.ValidateJSON[dto.RefreshTokenDTO](authHandler.Refresh)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/auth/logout", withTimeout(authenticated(middleware.AppHandler(authHandler.Logout)))).Methods(http.MethodPost)
+}
+
+func healthChecks(r *mux.Router, checks *health.Registry) {
+	healthHandler := handlers.NewHealthHandler(checks)
+
+	r.Handle("/health/live", middleware.AppHandler(healthHandler.Live)).Methods(http.MethodGet)
+	r.Handle("/health/ready", middleware.AppHandler(healthHandler.Ready)).Methods(http.MethodGet)
+	// /health continua respondendo como prontidão para quem ainda aponta para ele.
+	r.Handle("/health", middleware.AppHandler(healthHandler.Ready)).Methods(http.MethodGet)
 }
 
 func players(r *mux.Router, d Dependencies) {
-	playerHandler := handlers.NewPlayerHandler(d.RegisterPlayerUseCase)
+	playerHandler := handlers.NewPlayerHandler(
+		d.RegisterPlayerUseCase,
+		d.GetPlayer,
+		d.ListPlayers,
+		d.UpdatePlayer,
+		d.DeletePlayer,
+	)
 
 	r.Handle("/players",
-		middleware.ValidateJSON[dto.PlayerDTO](playerHandler.CreatePlayer),
+		withTimeout(middleware.ValidateJSON[dto.PlayerDTO](playerHandler.CreatePlayer)),
 	).Methods(http.MethodPost)
 
-	//r.Handle("/players/{id}", middleware.AppHandler(playerHandler.GetPlayerByID)).Methods(http.MethodGet)
-	//
-	//r.Handle("/players", middleware.AppHandler(playerHandler.GetPlayers)).Methods(http.MethodGet)
-	//
-	//r.Handle("/players/{id:[0-9]+}",
-	//	middleware.ValidateJSON[dto.PlayerDTO](playerHandler.UpdatePlayer),
-	//).Methods(http.MethodPut)
-	//
-	//r.Handle("/players/{id:[0-9]+}", middleware.AppHandler(playerHandler.DeletePlayer)).Methods(http.MethodDelete)
+	r.Handle("/players", withTimeout(middleware.AppHandler(playerHandler.GetPlayers))).Methods(http.MethodGet)
+
+	r.Handle("/players/{id:[0-9]+}", withTimeout(middleware.AppHandler(playerHandler.GetPlayerByID))).Methods(http.MethodGet)
+
+	r.Handle("/players/{id:[0-9]+}",
+		withTimeout(middleware.ValidateJSON[dto.PlayerUpdateDTO](playerHandler.UpdatePlayer)),
+	).Methods(http.MethodPut)
+
+	r.Handle("/players/{id:[0-9]+}", withTimeout(middleware.AppHandler(playerHandler.DeletePlayer))).Methods(http.MethodDelete)
+
+	cardHandler := handlers.NewPlayerCardHandler(d.GetPlayerCard)
+	r.Handle("/players/{id:[0-9]+}/card", withTimeout(middleware.AppHandler(cardHandler.GetPlayerCard))).Methods(http.MethodGet)
+}
+
+// playerStats fica fora dos grupos: a migração de stats percorre os jogadores de todos eles.
+func playerStats(r *mux.Router, d Dependencies) {
+	statsHandler := handlers.NewPlayerStatsHandler(d.MigrateStats)
+	r.Handle("/players/stats/migrate",
+		withLongTimeout(middleware.ValidateJSON[dto.MigratePlayerStatsDTO](statsHandler.MigrateStats)),
+	).Methods(http.MethodPost)
 }
 
-func HealthCheckHandler(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	_, _ = fmt.Fprintln(w, "OK")
+func groups(r *mux.Router, d Dependencies) {
+	groupHandler := newGroupHandler(d)
+
+	r.Handle("/groups",
+		withTimeout(middleware.ValidateJSON[dto.GroupDTO](groupHandler.CreateGroup)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/groups", withTimeout(middleware.AppHandler(groupHandler.GetGroups))).Methods(http.MethodGet)
+
+	r.Handle("/groups/join",
+		withTimeout(middleware.ValidateJSON[dto.JoinGroupDTO](groupHandler.JoinGroup)),
+	).Methods(http.MethodPost)
+}
+
+func members(r *mux.Router, d Dependencies) {
+	groupHandler := newGroupHandler(d)
+
+	r.Handle("/members", withTimeout(middleware.AppHandler(groupHandler.GetMembers))).Methods(http.MethodGet)
+
+	r.Handle("/members/{id:[0-9]+}/role",
+		withTimeout(middleware.ValidateJSON[dto.MemberRoleDTO](groupHandler.ChangeMemberRole)),
+	).Methods(http.MethodPut)
+
+	r.Handle("/join-code", withTimeout(middleware.AppHandler(groupHandler.RotateJoinCode))).Methods(http.MethodPost)
+}
+
+func newGroupHandler(d Dependencies) *handlers.GroupHandler {
+	return handlers.NewGroupHandler(
+		d.CreateGroup,
+		d.ListGroups,
+		d.JoinGroup,
+		d.ListMembers,
+		d.RotateJoinCode,
+		d.ChangeMemberRole,
+	)
+}
+
+func positions(r *mux.Router, d Dependencies) {
+	positionHandler := handlers.NewPositionHandler(
+		d.CreatePosition,
+		d.ListPositions,
+		d.UpdatePosition,
+		d.DeletePosition,
+	)
+
+	r.Handle("/positions",
+		withTimeout(middleware.ValidateJSON[dto.PositionDTO](positionHandler.CreatePosition)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/positions", withTimeout(middleware.AppHandler(positionHandler.GetPositions))).Methods(http.MethodGet)
+
+	r.Handle("/positions/{id:[0-9]+}",
+		withTimeout(middleware.ValidateJSON[dto.PositionPatchDTO](positionHandler.UpdatePosition)),
+	).Methods(http.MethodPatch)
+
+	r.Handle("/positions/{id:[0-9]+}", withTimeout(middleware.AppHandler(positionHandler.DeletePosition))).Methods(http.MethodDelete)
+}
+
+func matches(r *mux.Router, d Dependencies) {
+	matchHandler := handlers.NewMatchHandler(
+		d.CreateMatch,
+		d.GetMatch,
+		d.ListMatches,
+		d.UpdateMatch,
+	)
+
+	r.Handle("/matches",
+		withTimeout(middleware.ValidateJSON[dto.MatchDTO](matchHandler.CreateMatch)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/matches", withTimeout(middleware.AppHandler(matchHandler.GetMatches))).Methods(http.MethodGet)
+
+	r.Handle("/matches/{id:[0-9]+}", withTimeout(middleware.AppHandler(matchHandler.GetMatchByID))).Methods(http.MethodGet)
+
+	r.Handle("/matches/{id:[0-9]+}",
+		withTimeout(middleware.ValidateJSON[dto.MatchPatchDTO](matchHandler.UpdateMatch)),
+	).Methods(http.MethodPatch)
+
+	drawHandler := handlers.NewTeamDrawHandler(d.DrawTeams)
+	r.Handle("/matches/{id:[0-9]+}/draw-teams",
+		withTimeout(middleware.ValidateJSON[dto.DrawTeamsDTO](drawHandler.DrawTeams)),
+	).Methods(http.MethodPost)
+}
+
+func attendance(r *mux.Router, d Dependencies) {
+	attendanceHandler := handlers.NewAttendanceHandler(d.RSVP, d.GetRoster, d.RecordNoShows)
+
+	r.Handle("/matches/{id:[0-9]+}/rsvp",
+		withTimeout(middleware.ValidateJSON[dto.RSVPDTO](attendanceHandler.RSVP)),
+	).Methods(http.MethodPost)
+
+	r.Handle("/matches/{id:[0-9]+}/rsvp", withTimeout(middleware.AppHandler(attendanceHandler.GetRoster))).Methods(http.MethodGet)
+
+	r.Handle("/matches/{id:[0-9]+}/no-shows",
+		withTimeout(middleware.ValidateJSON[dto.NoShowsDTO](attendanceHandler.RecordNoShows)),
+	).Methods(http.MethodPut)
+}
+
+func ratings(r *mux.Router, d Dependencies) {
+	ratingHandler := handlers.NewRatingHandler(d.SubmitRatings)
+
+	r.Handle("/matches/{id:[0-9]+}/ratings",
+		withTimeout(middleware.ValidateJSON[dto.RatingSubmissionDTO](ratingHandler.SubmitRatings)),
+	).Methods(http.MethodPost)
+}
+
+func accounts(r *mux.Router, d Dependencies) {
+	accountHandler := handlers.NewAccountHandler(d.ChangeRole)
+
+	r.Handle("/accounts/{id:[0-9]+}/role",
+		withTimeout(middleware.ValidateJSON[dto.AccountRoleDTO](accountHandler.ChangeRole)),
+	).Methods(http.MethodPut)
 }
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"fut-app/pkg/env"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minSecretLength é o mínimo para uma chave HMAC-SHA256 com entropia razoável.
+const minSecretLength = 32
+
+var ErrWeakSecret = errors.New("AUTH_JWT_SECRET must have at least 32 bytes")
+
+type Config struct {
+	// Secret assina os tokens com HS256; sem ele a aplicação não sobe.
+	Secret          []byte
+	Issuer          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	BcryptCost      int
+}
+
+func NewConfig() Config {
+	return Config{
+		Secret:          []byte(os.Getenv("AUTH_JWT_SECRET")),
+		Issuer:          env.String("AUTH_ISSUER", "fut-app"),
+		AccessTokenTTL:  env.Duration("AUTH_ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL: env.Duration("AUTH_REFRESH_TOKEN_TTL", 7*24*time.Hour),
+		BcryptCost:      env.Int("AUTH_BCRYPT_COST", bcrypt.DefaultCost),
+	}
+}
+
+func (c Config) Validate() error {
+	if len(c.Secret) < minSecretLength {
+		return ErrWeakSecret
+	}
+	if c.AccessTokenTTL <= 0 || c.RefreshTokenTTL <= 0 {
+		return fmt.Errorf("token TTLs must be positive: access %s, refresh %s", c.AccessTokenTTL, c.RefreshTokenTTL)
+	}
+	if c.BcryptCost < bcrypt.MinCost || c.BcryptCost > bcrypt.MaxCost {
+		return fmt.Errorf("AUTH_BCRYPT_COST must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"errors"
+
+	appErr "fut-app/internal/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher guarda as senhas com bcrypt no custo configurado.
+type BcryptHasher struct {
+	cost int
+}
+
+func NewBcryptHasher(cost int) *BcryptHasher {
+	return &BcryptHasher{cost: cost}
+}
+
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Compare devolve ErrUnauthorized quando a senha não confere com o hash.
+func (h *BcryptHasher) Compare(hash, password string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return appErr.ErrUnauthorized
+	}
+	return err
+}
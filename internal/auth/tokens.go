@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type claims struct {
+	jwt.RegisteredClaims
+	Kind domain.TokenKind `json:"typ"`
+}
+
+// Tokens emite e verifica os JWT de acesso e de refresh, assinados com HS256.
+type Tokens struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+func NewTokens(cfg Config) *Tokens {
+	return &Tokens{
+		secret:     cfg.Secret,
+		issuer:     cfg.Issuer,
+		accessTTL:  cfg.AccessTokenTTL,
+		refreshTTL: cfg.RefreshTokenTTL,
+		now:        time.Now,
+	}
+}
+
+// Issue emite um par novo para principal; cada token tem seu próprio jti para ser revogado
+// separadamente.
+func (t *Tokens) Issue(principal domain.Principal) (*domain.TokenPair, error) {
+	access, err := t.sign(principal, domain.AccessToken, t.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := t.sign(principal, domain.RefreshToken, t.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TokenPair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		TokenType:    domain.TokenTypeBearer,
+		ExpiresIn:    t.accessTTL,
+	}, nil
+}
+
+// Parse verifica assinatura, emissor, validade e tipo do token. Qualquer falha vira
+// ErrUnauthorized, sem dizer ao cliente qual verificação falhou.
+func (t *Tokens) Parse(token string, kind domain.TokenKind) (*domain.TokenClaims, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (any, error) { return t.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(t.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(t.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", appErr.ErrUnauthorized, err)
+	}
+	if c.Kind != kind || c.ID == "" {
+		return nil, fmt.Errorf("%w: expected a %s token", appErr.ErrUnauthorized, kind)
+	}
+	accountID, err := strconv.ParseUint(c.Subject, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subject", appErr.ErrUnauthorized)
+	}
+
+	return &domain.TokenClaims{
+		Principal: domain.Principal{AccountID: uint(accountID)},
+		ID:        c.ID,
+		Kind:      c.Kind,
+		ExpiresAt: c.ExpiresAt.Time,
+	}, nil
+}
+
+func (t *Tokens) sign(principal domain.Principal, kind domain.TokenKind, ttl time.Duration) (string, error) {
+	now := t.now()
+	c := claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        newTokenID(),
+			Issuer:    t.issuer,
+			Subject:   strconv.FormatUint(uint64(principal.AccountID), 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Kind: kind,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(t.secret)
+}
+
+func newTokenID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
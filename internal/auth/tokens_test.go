@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() Config {
+	return Config{
+		Secret:          []byte(strings.Repeat("s", minSecretLength)),
+		Issuer:          "fut-app",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		BcryptCost:      4,
+	}
+}
+
+func TestTokens_IssueAndParse(t *testing.T) {
+	tokens := NewTokens(testConfig())
+	principal := domain.Principal{AccountID: 7}
+
+	pair, err := tokens.Issue(principal)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TokenTypeBearer, pair.TokenType)
+	assert.Equal(t, 15*time.Minute, pair.ExpiresIn)
+
+	access, err := tokens.Parse(pair.AccessToken, domain.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, principal, access.Principal)
+	assert.Equal(t, domain.AccessToken, access.Kind)
+
+	refresh, err := tokens.Parse(pair.RefreshToken, domain.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, access.ID, refresh.ID)
+}
+
+func TestTokens_ParseRejectsWrongKind(t *testing.T) {
+	tokens := NewTokens(testConfig())
+	pair, err := tokens.Issue(domain.Principal{AccountID: 1})
+	require.NoError(t, err)
+
+	_, err = tokens.Parse(pair.RefreshToken, domain.AccessToken)
+	assert.ErrorIs(t, err, appErr.ErrUnauthorized)
+}
+
+func TestTokens_ParseRejectsExpired(t *testing.T) {
+	tokens := NewTokens(testConfig())
+	tokens.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	pair, err := tokens.Issue(domain.Principal{AccountID: 1})
+	require.NoError(t, err)
+
+	tokens.now = time.Now
+	_, err = tokens.Parse(pair.AccessToken, domain.AccessToken)
+	assert.ErrorIs(t, err, appErr.ErrUnauthorized)
+}
+
+func TestTokens_ParseRejectsOtherSecretAndUnsigned(t *testing.T) {
+	other := testConfig()
+	other.Secret = []byte(strings.Repeat("x", minSecretLength))
+	pair, err := NewTokens(other).Issue(domain.Principal{AccountID: 1})
+	require.NoError(t, err)
+
+	tokens := NewTokens(testConfig())
+	_, err = tokens.Parse(pair.AccessToken, domain.AccessToken)
+	assert.ErrorIs(t, err, appErr.ErrUnauthorized)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims{
+		RegisteredClaims: jwt.RegisteredClaims{ID: "x", Issuer: "fut-app", Subject: "1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+		Kind:             domain.AccessToken,
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+	_, err = tokens.Parse(unsigned, domain.AccessToken)
+	assert.ErrorIs(t, err, appErr.ErrUnauthorized)
+}
+
+func TestBcryptHasher(t *testing.T) {
+	hasher := NewBcryptHasher(4)
+
+	hash, err := hasher.Hash("correct horse")
+	require.NoError(t, err)
+	assert.NotEqual(t, "correct horse", hash)
+
+	assert.NoError(t, hasher.Compare(hash, "correct horse"))
+	assert.ErrorIs(t, hasher.Compare(hash, "wrong horse"), appErr.ErrUnauthorized)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, testConfig().Validate())
+
+	weak := testConfig()
+	weak.Secret = []byte("short")
+	assert.ErrorIs(t, weak.Validate(), ErrWeakSecret)
+
+	cost := testConfig()
+	cost.BcryptCost = 40
+	assert.Error(t, cost.Validate())
+}
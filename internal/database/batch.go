@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const defaultBatchSize = 500
+
+type (
+	// Identifiable é satisfeito pelos models que embutem Model.
+	Identifiable interface {
+		PrimaryKey() uint
+	}
+
+	BatchOptions struct {
+		Size int
+	}
+)
+
+// Batch percorre os registros de T em lotes ordenados por ID, usando o último ID visto
+// como cursor (WHERE id > ? LIMIT n): ao contrário de Offset, linhas inseridas ou
+// removidas durante a execução não fazem o iterador pular nem repetir registros. O db
+// pode trazer filtros. Cancelar o contexto interrompe o processamento entre lotes e
+// devolve ctx.Err().
+func Batch[T Identifiable](ctx context.Context, db *gorm.DB, opts BatchOptions, fn func(tx *gorm.DB, batch []T) error) error {
+	if opts.Size <= 0 {
+		opts.Size = defaultBatchSize
+	}
+	query := db.WithContext(ctx).Model(new(T))
+	// O callback recebe uma sessão limpa, sem os filtros usados para selecionar os lotes.
+	session := db.Session(&gorm.Session{NewDB: true, Context: ctx})
+	primaryKey := clause.Column{Table: clause.CurrentTable, Name: clause.PrimaryKey}
+
+	var lastID uint
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var batch []T
+		err := query.Session(&gorm.Session{}).
+			Where(clause.Gt{Column: primaryKey, Value: lastID}).
+			Order(clause.OrderByColumn{Column: primaryKey}).
+			Limit(opts.Size).
+			Find(&batch).Error
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := fn(session, batch); err != nil {
+			return err
+		}
+
+		lastID = batch[len(batch)-1].PrimaryKey()
+		if len(batch) < opts.Size {
+			return nil
+		}
+	}
+}
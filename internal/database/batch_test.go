@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type batchItem struct {
+	Model
+	Value int
+}
+
+func setupBatchDB(t *testing.T, rows int) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql.DB: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&batchItem{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	items := make([]batchItem, rows)
+	for i := range items {
+		items[i].Value = i + 1
+	}
+	if err := db.Create(&items).Error; err != nil {
+		t.Fatalf("failed to create items: %v", err)
+	}
+	return db
+}
+
+func TestBatch_VisitsEveryRowOnce(t *testing.T) {
+	db := setupBatchDB(t, 10)
+	seen := map[uint]int{}
+
+	err := Batch(context.Background(), db, BatchOptions{Size: 3},
+		func(tx *gorm.DB, batch []batchItem) error {
+			for _, item := range batch {
+				seen[item.ID]++
+			}
+			// Apagar linhas já lidas desloca o OFFSET; o cursor por ID não é afetado.
+			return tx.Delete(&batch).Error
+		})
+	if err != nil {
+		t.Fatalf("Batch() error = %v", err)
+	}
+	if len(seen) != 10 {
+		t.Errorf("Batch() visited %d rows, want 10", len(seen))
+	}
+	for id, n := range seen {
+		if n != 1 {
+			t.Errorf("Batch() visited row %d %d times", id, n)
+		}
+	}
+}
+
+func TestBatch_AppliesFilters(t *testing.T) {
+	db := setupBatchDB(t, 10)
+	var values []int
+
+	err := Batch(context.Background(), db.Where("value % 2 = ?", 0), BatchOptions{Size: 2},
+		func(tx *gorm.DB, batch []batchItem) error {
+			for _, item := range batch {
+				values = append(values, item.Value)
+			}
+			// A sessão do callback não herda o filtro dos lotes.
+			var count int64
+			return tx.Model(&batchItem{}).Count(&count).Error
+		})
+	if err != nil {
+		t.Fatalf("Batch() error = %v", err)
+	}
+	if len(values) != 5 || values[0] != 2 || values[4] != 10 {
+		t.Errorf("Batch() values = %v, want the even values", values)
+	}
+}
+
+func TestBatch_StopsWhenContextIsCancelled(t *testing.T) {
+	db := setupBatchDB(t, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	batches := 0
+
+	err := Batch(ctx, db, BatchOptions{Size: 2}, func(tx *gorm.DB, batch []batchItem) error {
+		batches++
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Batch() error = %v, want context.Canceled", err)
+	}
+	if batches != 1 {
+		t.Errorf("Batch() ran %d batches after cancel, want 1", batches)
+	}
+}
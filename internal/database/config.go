@@ -3,14 +3,27 @@ package database
 import (
 	"fmt"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 
+	"fut-app/pkg/env"
+
 	"gorm.io/gorm/logger"
 )
 
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+
+	// SQLiteMemory abre um banco SQLite em memória, perdido ao fechar a conexão.
+	SQLiteMemory = ":memory:"
+)
+
 type Config struct {
+	// Driver escolhe o banco: DriverPostgres (padrão) ou DriverSQLite.
+	Driver string
+	// SQLitePath é o arquivo do banco SQLite, ou SQLiteMemory.
+	SQLitePath      string
 	Host            string
 	User            string
 	Password        string
@@ -22,47 +35,33 @@ type Config struct {
 	MaxOpenConns    int
 	ConnMaxLifetime time.Duration
 	LogLevel        logger.LogLevel
+	// SlowQueryThreshold é a duração a partir da qual uma consulta é logada como lenta.
+	SlowQueryThreshold time.Duration
+	Retry              RetryPolicy
 }
 
 func NewConfig() *Config {
 	return &Config{
-		Host:            getEnv("DB_HOST", "localhost"),
-		User:            getEnv("DB_USER", "admin"),
-		Password:        getEnv("DB_PASSWORD", "admin"),
-		DBName:          getEnv("DB_NAME", "futebol_stats"),
-		Port:            getEnv("DB_PORT", "5432"),
-		SSLMode:         getEnv("DB_SSLMODE", "disable"),
-		TimeZone:        getEnv("DB_TIMEZONE", "America/Sao_Paulo"),
-		MaxIdleConns:    getEnvAsInt("DB_MAX_IDLE_CONNS", 10),
-		MaxOpenConns:    getEnvAsInt("DB_MAX_OPEN_CONNS", 100),
-		ConnMaxLifetime: getEnvAsDuration("DB_CONN_MAX_LIFETIME", time.Hour),
-		LogLevel:        getEnvAsLogLevel("DB_LOG_LEVEL", logger.Error),
-	}
-}
-
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+		Driver:             strings.ToLower(env.String("DB_DRIVER", DriverPostgres)),
+		SQLitePath:         env.String("DB_SQLITE_PATH", "fut-app.db"),
+		Host:               env.String("DB_HOST", "localhost"),
+		User:               env.String("DB_USER", "admin"),
+		Password:           env.String("DB_PASSWORD", "admin"),
+		DBName:             env.String("DB_NAME", "futebol_stats"),
+		Port:               env.String("DB_PORT", "5432"),
+		SSLMode:            env.String("DB_SSLMODE", "disable"),
+		TimeZone:           env.String("DB_TIMEZONE", "America/Sao_Paulo"),
+		MaxIdleConns:       env.Int("DB_MAX_IDLE_CONNS", 10),
+		MaxOpenConns:       env.Int("DB_MAX_OPEN_CONNS", 100),
+		ConnMaxLifetime:    env.Duration("DB_CONN_MAX_LIFETIME", time.Hour),
+		LogLevel:           getEnvAsLogLevel("DB_LOG_LEVEL", logger.Error),
+		SlowQueryThreshold: env.Duration("DB_SLOW_QUERY_THRESHOLD", time.Second),
+		Retry: RetryPolicy{
+			MaxAttempts: env.Int("DB_RETRY_MAX_ATTEMPTS", 3),
+			BaseDelay:   env.Duration("DB_RETRY_BASE_DELAY", 50*time.Millisecond),
+			MaxDelay:    env.Duration("DB_RETRY_MAX_DELAY", time.Second),
+		},
 	}
-	return defaultValue
-}
-
-func getEnvAsInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if i, err := strconv.Atoi(value); err == nil {
-			return i
-		}
-	}
-	return defaultValue
-}
-
-func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
-	if value := os.Getenv(key); value != "" {
-		if d, err := time.ParseDuration(value); err == nil {
-			return d
-		}
-	}
-	return defaultValue
 }
 
 func getEnvAsLogLevel(key string, defaultValue logger.LogLevel) logger.LogLevel {
@@ -86,3 +85,9 @@ func (c *Config) GetDSN() string {
 		c.Host, c.User, c.Password, c.DBName, c.Port, c.SSLMode, c.TimeZone,
 	)
 }
+
+// SQLiteDSN liga as foreign keys, que o SQLite deixa desligadas por padrão, e espera
+// por locks em vez de falhar de imediato.
+func (c *Config) SQLiteDSN() string {
+	return c.SQLitePath + "?_foreign_keys=on&_busy_timeout=5000"
+}
@@ -3,114 +3,99 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
+	"log/slog"
 	"time"
 
+	applog "fut-app/pkg/logger"
+
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 type Database struct {
 	*gorm.DB
+	retry   RetryPolicy
+	retries retryCounters
+	logger  *slog.Logger
 }
 
 func NewDatabase(config *Config) (*Database, error) {
-	gormLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             time.Second,
-			LogLevel:                  config.LogLevel,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
-
 	gormConfig := &gorm.Config{
-		Logger: gormLogger,
+		Logger: NewGormLogger(slog.Default(), config.LogLevel, config.SlowQueryThreshold),
 		NowFunc: func() time.Time {
 			return time.Now().Local()
 		},
 	}
 
-	db, err := gorm.Open(postgres.Open(config.GetDSN()), gormConfig)
+	dialector, err := newDialector(config)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector, gormConfig)
 	if err != nil {
 		return nil, fmt.Errorf("❌ Failed to connect to the database: %w", err)
 	}
+	if err := db.Use(tracingPlugin{}); err != nil {
+		return nil, fmt.Errorf("❌ Failed to install the tracing plugin: %w", err)
+	}
 
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, fmt.Errorf("❌ Failed to get sql.DB: %w", err)
 	}
 
-	sqlDB.SetMaxIdleConns(config.MaxIdleConns)
-	sqlDB.SetMaxOpenConns(config.MaxOpenConns)
-	sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	if config.Driver == DriverSQLite {
+		// O SQLite serializa as escritas e cada conexão com :memory: é um banco novo:
+		// uma única conexão que nunca expira mantém o banco e evita "database is locked".
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+	} else {
+		sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+		sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+		sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
+
+	return &Database{DB: db, retry: config.Retry, logger: slog.Default()}, nil
+}
 
-	return &Database{db}, nil
+func newDialector(config *Config) (gorm.Dialector, error) {
+	switch config.Driver {
+	case DriverPostgres, "":
+		return postgres.Open(config.GetDSN()), nil
+	case DriverSQLite:
+		return sqlite.Open(config.SQLiteDSN()), nil
+	default:
+		return nil, fmt.Errorf("❌ Unsupported DB_DRIVER %q: use %s or %s", config.Driver, DriverPostgres, DriverSQLite)
+	}
 }
 
-// Transaction executa uma função dentro de uma transação
+// Transaction executa fn dentro de uma transação; deadlocks e falhas de serialização
+// desfazem a transação inteira, que é repetida conforme a RetryPolicy.
 func (db *Database) Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
-	return db.WithContext(ctx).Transaction(fn)
+	return db.Execute(ctx, func(tx *gorm.DB) error {
+		return tx.Transaction(fn)
+	})
 }
 
-// Execute executa uma operação de banco de dados com retry em caso de erro
+// Execute executa uma operação de banco de dados com retry em caso de erro transitório.
+// A operação é repetida por inteiro, então precisa ser idempotente ou transacional.
 func (db *Database) Execute(ctx context.Context, operation func(tx *gorm.DB) error) error {
-	maxRetries := 3
-	var err error
-
-	for i := 0; i < maxRetries; i++ {
-		err = operation(db.WithContext(ctx))
-		if err == nil {
-			return nil
-		}
-
-		if !db.shouldRetry(err) {
-			return err
-		}
-
-		time.Sleep(time.Duration(i+1) * 100 * time.Millisecond)
+	fallback := db.logger
+	if fallback == nil {
+		fallback = slog.Default()
 	}
-
-	return fmt.Errorf("❌ maximum attempts exceeded: %w", err)
+	return retry(ctx, db.retry, &db.retries, applog.FromContextOr(ctx, fallback), func() error {
+		return operation(db.WithContext(ctx))
+	})
 }
 
-// shouldRetry verifica se deve tentar novamente baseado no erro
-func (db *Database) shouldRetry(err error) bool {
-	// Adicione aqui condições para retry baseadas nos erros
-	// Por exemplo, deadlocks, timeouts, etc.
-	return false
-}
-
-// Batch processa registros em lotes
-func (db *Database) Batch(ctx context.Context, batchSize int, model interface{}, fn func(tx *gorm.DB, batch []interface{}) error) error {
-	var offset int
-	for {
-		var batch []interface{}
-		result := db.WithContext(ctx).
-			Model(model).
-			Offset(offset).
-			Limit(batchSize).
-			Find(&batch)
-
-		if result.Error != nil {
-			return result.Error
-		}
-
-		if len(batch) == 0 {
-			break
-		}
-
-		if err := fn(db.DB, batch); err != nil {
-			return err
-		}
-
-		offset += len(batch)
-	}
-	return nil
+// RetryStats devolve quantas operações foram repetidas e quantas esgotaram as tentativas.
+func (db *Database) RetryStats() RetryStats {
+	return RetryStats{Retries: db.retries.retries.Load(), Exhausted: db.retries.exhausted.Load()}
 }
 
 // SafeDelete realiza uma deleção segura (soft delete)
@@ -127,6 +112,36 @@ func (db *Database) Health(ctx context.Context) error {
 	return sqlDB.PingContext(ctx)
 }
 
+// HealthCheck mede o ping e devolve as estatísticas do pool de conexões; serve de
+// verificação de prontidão para o registro de health.
+func (db *Database) HealthCheck(ctx context.Context) (map[string]any, error) {
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return nil, fmt.Errorf("❌ Failed to get connection: %w", err)
+	}
+
+	start := time.Now()
+	err = sqlDB.PingContext(ctx)
+	ping := time.Since(start)
+
+	stats := sqlDB.Stats()
+	details := map[string]any{
+		"driver":  db.Dialector.Name(),
+		"ping_ms": float64(ping.Microseconds()) / 1000,
+		"pool": map[string]any{
+			"max_open":            stats.MaxOpenConnections,
+			"open":                stats.OpenConnections,
+			"in_use":              stats.InUse,
+			"idle":                stats.Idle,
+			"wait_count":          stats.WaitCount,
+			"wait_duration_ms":    stats.WaitDuration.Milliseconds(),
+			"max_idle_closed":     stats.MaxIdleClosed,
+			"max_lifetime_closed": stats.MaxLifetimeClosed,
+		},
+	}
+	return details, err
+}
+
 // Close fecha a conexão com o banco
 func (db *Database) Close() error {
 	sqlDB, err := db.DB.DB()
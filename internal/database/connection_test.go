@@ -0,0 +1,145 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/migrations"
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm/logger"
+)
+
+func setupSQLiteDatabase(t *testing.T, path string) *database.Database {
+	db, err := database.NewDatabase(&database.Config{Driver: database.DriverSQLite, SQLitePath: path, LogLevel: logger.Silent})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	embedded, err := migrations.Embedded(db.Dialector.Name())
+	if err != nil {
+		t.Fatalf("Embedded() error = %v", err)
+	}
+	if _, err := migrations.NewMigrator(db.DB, embedded, slog.Default()).Up(context.Background()); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if err := repositories.NewPosition(db.DB, slog.Default()).SeedPositions(context.Background(), domain.StandardPositions); err != nil {
+		t.Fatalf("SeedPositions() error = %v", err)
+	}
+	return db
+}
+
+// groupContext cria um grupo e devolve o contexto de uma requisição dentro dele.
+func groupContext(t *testing.T, db *database.Database) context.Context {
+	if err := db.Exec("INSERT INTO groups (name, join_code) VALUES ('Pelada', 'TESTE234')").Error; err != nil {
+		t.Fatalf("failed to create group: %v", err)
+	}
+	var groupID uint
+	if err := db.Raw("SELECT id FROM groups WHERE join_code = 'TESTE234'").Scan(&groupID).Error; err != nil {
+		t.Fatalf("failed to read group: %v", err)
+	}
+	return domain.WithMembership(context.Background(), domain.Membership{GroupID: groupID})
+}
+
+func TestNewDatabase_UnknownDriver(t *testing.T) {
+	_, err := database.NewDatabase(&database.Config{Driver: "mysql"})
+	if err == nil {
+		t.Fatal("NewDatabase() error = nil, want unsupported driver error")
+	}
+}
+
+func TestNewDatabase_SQLiteMemory(t *testing.T) {
+	db := setupSQLiteDatabase(t, database.SQLiteMemory)
+	players := repositories.NewPlayer(db, slog.Default())
+	ctx := groupContext(t, db)
+
+	stats := domain.Stats{Finishing: 70, Passing: 85, Speed: 80, Defense: 60, Stamina: 78, Highlight: 75}
+	created, err := players.CreatePlayer(ctx, domain.Player{Name: "Zico", Stats: stats, Position: []string{"CAM", "Atacante"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	got, err := players.GetPlayerByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetPlayerByID() error = %v", err)
+	}
+	if got.Stats != stats {
+		t.Errorf("GetPlayerByID() stats = %+v, want %+v", got.Stats, stats)
+	}
+	if len(got.Position) != 2 {
+		t.Errorf("GetPlayerByID() positions = %v, want 2 positions", got.Position)
+	}
+}
+
+func TestNewDatabase_SQLiteEnforcesConstraints(t *testing.T) {
+	db := setupSQLiteDatabase(t, database.SQLiteMemory)
+
+	// As foreign keys vêm desligadas no SQLite; o DSN precisa ligá-las.
+	err := db.Exec("INSERT INTO player_positions (player_id, position_id) VALUES (404, 1)").Error
+	if err == nil {
+		t.Error("insert into player_positions without a player should violate the foreign key")
+	}
+
+	// Desliga as FKs para que a nota sem partida chegue até a CHECK 45–99.
+	if err := db.Exec("PRAGMA foreign_keys = OFF").Error; err != nil {
+		t.Fatalf("failed to disable foreign keys: %v", err)
+	}
+	_, err = repositories.NewRating(db, slog.Default()).CreateRatings(groupContext(t, db), []domain.Rating{{
+		MatchID: 1, RaterID: 1, RatedPlayerID: 2,
+		Finishing: 100, Passing: 70, Speed: 70, Defense: 70, Stamina: 70, Highlight: 70,
+	}})
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Errorf("CreateRatings() error = %v, want ValidationErrors from the CHECK constraint", err)
+	}
+}
+
+func TestNewDatabase_SQLiteFile(t *testing.T) {
+	path := t.TempDir() + "/fut-app.db"
+	db := setupSQLiteDatabase(t, path)
+	ctx := groupContext(t, db)
+	if _, err := repositories.NewPlayer(db, slog.Default()).CreatePlayer(ctx, domain.Player{
+		Name:     "Sócrates",
+		Stats:    domain.Stats{Finishing: 70, Passing: 85, Speed: 80, Defense: 60, Stamina: 78, Highlight: 75},
+		Position: []string{"Meio-campo"},
+	}); err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+	_ = db.Close()
+
+	reopened := setupSQLiteDatabase(t, path)
+	page, err := repositories.NewPlayer(reopened, slog.Default()).ListPlayers(ctx, domain.PageRequest{})
+	if err != nil {
+		t.Fatalf("ListPlayers() error = %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Name != "Sócrates" {
+		t.Errorf("ListPlayers() = %+v, want the player stored in the file", page)
+	}
+}
+
+func TestDatabase_HealthCheck(t *testing.T) {
+	db := setupSQLiteDatabase(t, database.SQLiteMemory)
+
+	details, err := db.HealthCheck(context.Background())
+	if err != nil {
+		t.Fatalf("HealthCheck() error = %v", err)
+	}
+	if details["driver"] != database.DriverSQLite {
+		t.Errorf("HealthCheck() driver = %v, want sqlite", details["driver"])
+	}
+	pool, ok := details["pool"].(map[string]any)
+	if !ok || pool["max_open"] != 1 {
+		t.Errorf("HealthCheck() pool = %v, want the single SQLite connection", details["pool"])
+	}
+
+	_ = db.Close()
+	if _, err := db.HealthCheck(context.Background()); err == nil {
+		t.Error("HealthCheck() after Close error = nil, want ping failure")
+	}
+}
@@ -0,0 +1,77 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	accountGateway struct {
+		repo repositories.Account
+	}
+)
+
+func NewRegisterAccountGateway(repo repositories.Account) usecase.RegisterAccountGateway {
+	return &accountGateway{repo: repo}
+}
+
+func NewLoginGateway(repo repositories.Account) usecase.LoginGateway {
+	return &accountGateway{repo: repo}
+}
+
+func NewRefreshTokenGateway(repo repositories.Account) usecase.RefreshTokenGateway {
+	return &accountGateway{repo: repo}
+}
+
+func NewLogoutGateway(repo repositories.Account) usecase.LogoutGateway {
+	return &accountGateway{repo: repo}
+}
+
+func NewAuthenticateGateway(repo repositories.Account) usecase.AuthenticateGateway {
+	return &accountGateway{repo: repo}
+}
+
+func NewChangeAccountRoleGateway(repo repositories.Account) usecase.ChangeAccountRoleGateway {
+	return &accountGateway{repo: repo}
+}
+
+func (g *accountGateway) Create(ctx context.Context, account domain.Account) (_ *domain.Account, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Account.Create")
+	defer tracing.End(span, &err)
+	return g.repo.CreateAccount(ctx, account)
+}
+
+func (g *accountGateway) Get(ctx context.Context, id uint) (_ *domain.Account, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Account.Get")
+	defer tracing.End(span, &err)
+	return g.repo.GetAccountByID(ctx, id)
+}
+
+func (g *accountGateway) GetByEmail(ctx context.Context, email string) (_ *domain.Account, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Account.GetByEmail")
+	defer tracing.End(span, &err)
+	return g.repo.GetAccountByEmail(ctx, email)
+}
+
+func (g *accountGateway) Revoke(ctx context.Context, tokenID string, expiresAt time.Time) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Account.Revoke")
+	defer tracing.End(span, &err)
+	return g.repo.RevokeToken(ctx, tokenID, expiresAt)
+}
+
+func (g *accountGateway) IsRevoked(ctx context.Context, tokenID string) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Account.IsRevoked")
+	defer tracing.End(span, &err)
+	return g.repo.IsTokenRevoked(ctx, tokenID)
+}
+
+func (g *accountGateway) SetRole(ctx context.Context, id uint, role domain.Role) (_ *domain.Account, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Account.SetRole")
+	defer tracing.End(span, &err)
+	return g.repo.SetAccountRole(ctx, id, role)
+}
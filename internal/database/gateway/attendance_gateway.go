@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	attendanceGateway struct {
+		matchRepo      repositories.Match
+		attendanceRepo repositories.Attendance
+	}
+)
+
+func NewRSVPGateway(matchRepo repositories.Match, attendanceRepo repositories.Attendance) usecase.RSVPGateway {
+	return &attendanceGateway{matchRepo: matchRepo, attendanceRepo: attendanceRepo}
+}
+
+func NewGetRosterGateway(attendanceRepo repositories.Attendance) usecase.GetRosterGateway {
+	return &attendanceGateway{attendanceRepo: attendanceRepo}
+}
+
+func NewRecordNoShowsGateway(matchRepo repositories.Match, attendanceRepo repositories.Attendance) usecase.RecordNoShowsGateway {
+	return &attendanceGateway{matchRepo: matchRepo, attendanceRepo: attendanceRepo}
+}
+
+func (g *attendanceGateway) GetMatch(ctx context.Context, id uint) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Attendance.GetMatch")
+	defer tracing.End(span, &err)
+	return g.matchRepo.GetMatchByID(ctx, id)
+}
+
+func (g *attendanceGateway) Respond(ctx context.Context, rsvp domain.RSVP, now time.Time) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Attendance.Respond")
+	defer tracing.End(span, &err)
+	return g.attendanceRepo.RespondRSVP(ctx, rsvp, now)
+}
+
+func (g *attendanceGateway) GetRoster(ctx context.Context, matchID uint) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Attendance.GetRoster")
+	defer tracing.End(span, &err)
+	return g.attendanceRepo.GetRoster(ctx, matchID)
+}
+
+func (g *attendanceGateway) SetNoShows(ctx context.Context, matchID uint, playerIDs []uint) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Attendance.SetNoShows")
+	defer tracing.End(span, &err)
+	return g.attendanceRepo.SetNoShows(ctx, matchID, playerIDs)
+}
@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	createMatchGateway struct {
+		repo repositories.Match
+	}
+)
+
+func NewCreateMatchGateway(repo repositories.Match) usecase.CreateMatchGateway {
+	return &createMatchGateway{repo: repo}
+}
+
+func (g *createMatchGateway) Create(ctx context.Context, match domain.Match) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.CreateMatch.Create")
+	defer tracing.End(span, &err)
+	return g.repo.CreateMatch(ctx, match)
+}
@@ -0,0 +1,25 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	deletePlayerGateway struct {
+		repo repositories.Player
+	}
+)
+
+func NewDeletePlayerGateway(repo repositories.Player) usecase.DeletePlayerGateway {
+	return &deletePlayerGateway{repo: repo}
+}
+
+func (g *deletePlayerGateway) Delete(ctx context.Context, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.DeletePlayer.Delete")
+	defer tracing.End(span, &err)
+	return g.repo.DeletePlayer(ctx, id)
+}
@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	drawTeamsGateway struct {
+		matchRepo      repositories.Match
+		attendanceRepo repositories.Attendance
+		playerRepo     repositories.Player
+		positionRepo   repositories.Position
+	}
+)
+
+func NewDrawTeamsGateway(matchRepo repositories.Match, attendanceRepo repositories.Attendance, playerRepo repositories.Player, positionRepo repositories.Position) usecase.DrawTeamsGateway {
+	return &drawTeamsGateway{matchRepo: matchRepo, attendanceRepo: attendanceRepo, playerRepo: playerRepo, positionRepo: positionRepo}
+}
+
+func (g *drawTeamsGateway) GetMatch(ctx context.Context, id uint) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.DrawTeams.GetMatch")
+	defer tracing.End(span, &err)
+	return g.matchRepo.GetMatchByID(ctx, id)
+}
+
+func (g *drawTeamsGateway) GetRoster(ctx context.Context, matchID uint) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.DrawTeams.GetRoster")
+	defer tracing.End(span, &err)
+	return g.attendanceRepo.GetRoster(ctx, matchID)
+}
+
+func (g *drawTeamsGateway) GetPlayers(ctx context.Context, ids []uint) (_ []domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.DrawTeams.GetPlayers")
+	defer tracing.End(span, &err)
+	return g.playerRepo.GetPlayersByIDs(ctx, ids)
+}
+
+func (g *drawTeamsGateway) GetPositions(ctx context.Context) (_ []domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.DrawTeams.GetPositions")
+	defer tracing.End(span, &err)
+	return g.positionRepo.GetPositions(ctx)
+}
+
+func (g *drawTeamsGateway) SaveMatch(ctx context.Context, match domain.Match) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.DrawTeams.SaveMatch")
+	defer tracing.End(span, &err)
+	// O sorteio só troca as escalações, então o status esperado é o atual.
+	return g.matchRepo.UpdateMatch(ctx, match, match.Status)
+}
@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	getMatchGateway struct {
+		repo repositories.Match
+	}
+)
+
+func NewGetMatchGateway(repo repositories.Match) usecase.GetMatchGateway {
+	return &getMatchGateway{repo: repo}
+}
+
+func (g *getMatchGateway) Get(ctx context.Context, id uint) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetMatch.Get")
+	defer tracing.End(span, &err)
+	return g.repo.GetMatchByID(ctx, id)
+}
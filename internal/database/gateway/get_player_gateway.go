@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	getPlayerGateway struct {
+		repo repositories.Player
+	}
+)
+
+func NewGetPlayerGateway(repo repositories.Player) usecase.GetPlayerGateway {
+	return &getPlayerGateway{repo: repo}
+}
+
+func (g *getPlayerGateway) Get(ctx context.Context, id uint) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.GetPlayer.Get")
+	defer tracing.End(span, &err)
+	return g.repo.GetPlayerByID(ctx, id)
+}
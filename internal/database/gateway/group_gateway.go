@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	groupGateway struct {
+		repo repositories.Group
+	}
+)
+
+func NewCreateGroupGateway(repo repositories.Group) usecase.CreateGroupGateway {
+	return &groupGateway{repo: repo}
+}
+
+func NewJoinGroupGateway(repo repositories.Group) usecase.JoinGroupGateway {
+	return &groupGateway{repo: repo}
+}
+
+func NewListGroupsGateway(repo repositories.Group) usecase.ListGroupsGateway {
+	return &groupGateway{repo: repo}
+}
+
+func NewGetMembershipGateway(repo repositories.Group) usecase.GetMembershipGateway {
+	return &groupGateway{repo: repo}
+}
+
+func NewListMembersGateway(repo repositories.Group) usecase.ListMembersGateway {
+	return &groupGateway{repo: repo}
+}
+
+func NewRotateJoinCodeGateway(repo repositories.Group) usecase.RotateJoinCodeGateway {
+	return &groupGateway{repo: repo}
+}
+
+func NewChangeMemberRoleGateway(repo repositories.Group) usecase.ChangeMemberRoleGateway {
+	return &groupGateway{repo: repo}
+}
+
+func (g *groupGateway) Create(ctx context.Context, group domain.Group, accountID uint, player domain.Player) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.Create")
+	defer tracing.End(span, &err)
+	return g.repo.CreateGroup(ctx, group, accountID, player)
+}
+
+func (g *groupGateway) Join(ctx context.Context, code string, accountID uint, player domain.Player) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.Join")
+	defer tracing.End(span, &err)
+	return g.repo.JoinGroup(ctx, code, accountID, player)
+}
+
+func (g *groupGateway) ListByAccount(ctx context.Context, accountID uint) (_ []domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.ListByAccount")
+	defer tracing.End(span, &err)
+	return g.repo.GetGroupsByAccount(ctx, accountID)
+}
+
+func (g *groupGateway) Get(ctx context.Context, groupID uint) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.Get")
+	defer tracing.End(span, &err)
+	return g.repo.GetGroupByID(ctx, groupID)
+}
+
+func (g *groupGateway) Membership(ctx context.Context, groupID, accountID uint) (_ *domain.Membership, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.Membership")
+	defer tracing.End(span, &err)
+	return g.repo.GetMembership(ctx, groupID, accountID)
+}
+
+func (g *groupGateway) Members(ctx context.Context) (_ []domain.GroupMember, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.Members")
+	defer tracing.End(span, &err)
+	return g.repo.GetMembers(ctx)
+}
+
+func (g *groupGateway) SetJoinCode(ctx context.Context, code string) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.SetJoinCode")
+	defer tracing.End(span, &err)
+	return g.repo.SetJoinCode(ctx, code)
+}
+
+func (g *groupGateway) SetMemberRole(ctx context.Context, playerID uint, role domain.Role) (_ *domain.GroupMember, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Group.SetMemberRole")
+	defer tracing.End(span, &err)
+	return g.repo.SetMemberRole(ctx, playerID, role)
+}
@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	listMatchesGateway struct {
+		repo repositories.Match
+	}
+)
+
+func NewListMatchesGateway(repo repositories.Match) usecase.ListMatchesGateway {
+	return &listMatchesGateway{repo: repo}
+}
+
+func (g *listMatchesGateway) List(ctx context.Context) (_ []domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListMatches.List")
+	defer tracing.End(span, &err)
+	return g.repo.GetMatches(ctx)
+}
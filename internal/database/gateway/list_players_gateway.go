@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	listPlayersGateway struct {
+		repo repositories.Player
+	}
+)
+
+func NewListPlayersGateway(repo repositories.Player) usecase.ListPlayersGateway {
+	return &listPlayersGateway{repo: repo}
+}
+
+func (g *listPlayersGateway) List(ctx context.Context, req domain.PageRequest) (_ *domain.Page[domain.Player], err error) {
+	ctx, span := tracing.Start(ctx, "gateway.ListPlayers.List")
+	defer tracing.End(span, &err)
+	return g.repo.ListPlayers(ctx, req)
+}
@@ -0,0 +1,32 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	migratePlayerStatsGateway struct {
+		repo repositories.Player
+	}
+)
+
+func NewMigratePlayerStatsGateway(repo repositories.Player) usecase.MigratePlayerStatsGateway {
+	return &migratePlayerStatsGateway{repo: repo}
+}
+
+func (g *migratePlayerStatsGateway) EachStoredStats(ctx context.Context, fn func([]domain.StoredStats) error) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.MigratePlayerStats.EachStoredStats")
+	defer tracing.End(span, &err)
+	return g.repo.EachStoredStats(ctx, fn)
+}
+
+func (g *migratePlayerStatsGateway) SaveStats(ctx context.Context, playerID uint, stats domain.Stats) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.MigratePlayerStats.SaveStats")
+	defer tracing.End(span, &err)
+	return g.repo.UpdateStoredStats(ctx, playerID, stats)
+}
@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	playerCardGateway struct {
+		playerRepo     repositories.Player
+		ratingRepo     repositories.Rating
+		attendanceRepo repositories.Attendance
+	}
+)
+
+func NewGetPlayerCardGateway(playerRepo repositories.Player, ratingRepo repositories.Rating, attendanceRepo repositories.Attendance) usecase.GetPlayerCardGateway {
+	return &playerCardGateway{playerRepo: playerRepo, ratingRepo: ratingRepo, attendanceRepo: attendanceRepo}
+}
+
+func NewRecomputePlayerCardsGateway(playerRepo repositories.Player, ratingRepo repositories.Rating) usecase.RecomputePlayerCardsGateway {
+	return &playerCardGateway{playerRepo: playerRepo, ratingRepo: ratingRepo}
+}
+
+func (g *playerCardGateway) GetPlayer(ctx context.Context, id uint) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.PlayerCard.GetPlayer")
+	defer tracing.End(span, &err)
+	return g.playerRepo.GetPlayerByID(ctx, id)
+}
+
+func (g *playerCardGateway) ReceivedRatings(ctx context.Context, playerID uint) (_ []domain.Rating, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.PlayerCard.ReceivedRatings")
+	defer tracing.End(span, &err)
+	return g.ratingRepo.GetRatingsReceived(ctx, playerID)
+}
+
+func (g *playerCardGateway) Reliability(ctx context.Context, playerID uint) (_ *domain.Reliability, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.PlayerCard.Reliability")
+	defer tracing.End(span, &err)
+	return g.attendanceRepo.GetReliability(ctx, playerID)
+}
+
+func (g *playerCardGateway) SaveStats(ctx context.Context, playerID uint, stats domain.Stats) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.PlayerCard.SaveStats")
+	defer tracing.End(span, &err)
+	return g.playerRepo.UpdateStats(ctx, playerID, stats)
+}
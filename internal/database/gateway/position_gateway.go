@@ -0,0 +1,72 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	positionGateway struct {
+		repo repositories.Position
+	}
+)
+
+func NewCreatePositionGateway(repo repositories.Position) usecase.CreatePositionGateway {
+	return &positionGateway{repo: repo}
+}
+
+func NewListPositionsGateway(repo repositories.Position) usecase.ListPositionsGateway {
+	return &positionGateway{repo: repo}
+}
+
+func NewUpdatePositionGateway(repo repositories.Position) usecase.UpdatePositionGateway {
+	return &positionGateway{repo: repo}
+}
+
+func NewDeletePositionGateway(repo repositories.Position) usecase.DeletePositionGateway {
+	return &positionGateway{repo: repo}
+}
+
+func NewSeedPositionsGateway(repo repositories.Position) usecase.SeedPositionsGateway {
+	return &positionGateway{repo: repo}
+}
+
+func (g *positionGateway) Create(ctx context.Context, position domain.Position) (_ *domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Position.Create")
+	defer tracing.End(span, &err)
+	return g.repo.CreatePosition(ctx, position)
+}
+
+func (g *positionGateway) List(ctx context.Context) (_ []domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Position.List")
+	defer tracing.End(span, &err)
+	return g.repo.GetPositions(ctx)
+}
+
+func (g *positionGateway) Get(ctx context.Context, id uint) (_ *domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Position.Get")
+	defer tracing.End(span, &err)
+	return g.repo.GetPositionByID(ctx, id)
+}
+
+func (g *positionGateway) Update(ctx context.Context, position domain.Position) (_ *domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Position.Update")
+	defer tracing.End(span, &err)
+	return g.repo.UpdatePosition(ctx, position)
+}
+
+func (g *positionGateway) Delete(ctx context.Context, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Position.Delete")
+	defer tracing.End(span, &err)
+	return g.repo.DeletePosition(ctx, id)
+}
+
+func (g *positionGateway) Seed(ctx context.Context, positions []domain.Position) (err error) {
+	ctx, span := tracing.Start(ctx, "gateway.Position.Seed")
+	defer tracing.End(span, &err)
+	return g.repo.SeedPositions(ctx, positions)
+}
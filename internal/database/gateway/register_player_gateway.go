@@ -1,9 +1,12 @@
 package gateway
 
 import (
+	"context"
+
 	"fut-app/internal/database/repositories"
 	"fut-app/internal/domain"
 	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
 )
 
 type (
@@ -16,6 +19,8 @@ func NewRegisterPlayerGateway(repo repositories.Player) usecase.RegisterPlayerGa
 	return &registerPlayerGateway{repo: repo}
 }
 
-func (g *registerPlayerGateway) Register(player domain.Player) (*domain.Player, error) {
-	return g.repo.CreatePlayer(player)
+func (g *registerPlayerGateway) Register(ctx context.Context, player domain.Player) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.RegisterPlayer.Register")
+	defer tracing.End(span, &err)
+	return g.repo.CreatePlayer(ctx, player)
 }
@@ -0,0 +1,39 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	submitRatingsGateway struct {
+		matchRepo  repositories.Match
+		ratingRepo repositories.Rating
+	}
+)
+
+func NewSubmitRatingsGateway(matchRepo repositories.Match, ratingRepo repositories.Rating) usecase.SubmitRatingsGateway {
+	return &submitRatingsGateway{matchRepo: matchRepo, ratingRepo: ratingRepo}
+}
+
+func (g *submitRatingsGateway) GetMatch(ctx context.Context, id uint) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.SubmitRatings.GetMatch")
+	defer tracing.End(span, &err)
+	return g.matchRepo.GetMatchByID(ctx, id)
+}
+
+func (g *submitRatingsGateway) RatedPlayers(ctx context.Context, matchID, raterID uint) (_ []uint, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.SubmitRatings.RatedPlayers")
+	defer tracing.End(span, &err)
+	return g.ratingRepo.GetRatedPlayerIDs(ctx, matchID, raterID)
+}
+
+func (g *submitRatingsGateway) Save(ctx context.Context, ratings []domain.Rating) (_ []domain.Rating, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.SubmitRatings.Save")
+	defer tracing.End(span, &err)
+	return g.ratingRepo.CreateRatings(ctx, ratings)
+}
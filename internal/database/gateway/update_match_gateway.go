@@ -0,0 +1,32 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	updateMatchGateway struct {
+		repo repositories.Match
+	}
+)
+
+func NewUpdateMatchGateway(repo repositories.Match) usecase.UpdateMatchGateway {
+	return &updateMatchGateway{repo: repo}
+}
+
+func (g *updateMatchGateway) Get(ctx context.Context, id uint) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.UpdateMatch.Get")
+	defer tracing.End(span, &err)
+	return g.repo.GetMatchByID(ctx, id)
+}
+
+func (g *updateMatchGateway) Update(ctx context.Context, match domain.Match, from domain.MatchStatus) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.UpdateMatch.Update")
+	defer tracing.End(span, &err)
+	return g.repo.UpdateMatch(ctx, match, from)
+}
@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"fut-app/internal/database/repositories"
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	updatePlayerGateway struct {
+		repo repositories.Player
+	}
+)
+
+func NewUpdatePlayerGateway(repo repositories.Player) usecase.UpdatePlayerGateway {
+	return &updatePlayerGateway{repo: repo}
+}
+
+func (g *updatePlayerGateway) Update(ctx context.Context, id uint, update domain.PlayerUpdate) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "gateway.UpdatePlayer.Update")
+	defer tracing.End(span, &err)
+	return g.repo.UpdatePlayer(ctx, id, update)
+}
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	applog "fut-app/pkg/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// gormLogger manda os logs do GORM para o slog, usando o logger da requisição quando o
+// contexto tiver um, para que o SQL saia com o mesmo request ID.
+type gormLogger struct {
+	base          *slog.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+}
+
+// NewGormLogger cria o adaptador; level segue DB_LOG_LEVEL e consultas acima de
+// slowThreshold viram Warn (zero desliga o aviso).
+func NewGormLogger(base *slog.Logger, level logger.LogLevel, slowThreshold time.Duration) logger.Interface {
+	return &gormLogger{base: base, level: level, slowThreshold: slowThreshold}
+}
+
+func (l *gormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Info {
+		l.log(ctx).Info(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Warn {
+		l.log(ctx).Warn(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Error {
+		l.log(ctx).Error(fmt.Sprintf(msg, args...))
+	}
+}
+
+// Trace registra a consulta: erros (menos registro não encontrado) em Error, consultas
+// lentas em Warn e as demais em Info, conforme o nível configurado.
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	attrs := func() []slog.Attr {
+		sql, rows := fc()
+		return []slog.Attr{
+			slog.String("sql", sql),
+			slog.Int64("rows", rows),
+			slog.Duration("elapsed", elapsed),
+		}
+	}
+
+	switch {
+	case err != nil && l.level >= logger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		l.log(ctx).LogAttrs(ctx, slog.LevelError, "query failed", append(attrs(), slog.String("error", err.Error()))...)
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= logger.Warn:
+		l.log(ctx).LogAttrs(ctx, slog.LevelWarn, "slow query", append(attrs(), slog.Duration("threshold", l.slowThreshold))...)
+	case l.level >= logger.Info:
+		l.log(ctx).LogAttrs(ctx, slog.LevelInfo, "query", attrs()...)
+	}
+}
+
+func (l *gormLogger) log(ctx context.Context) *slog.Logger {
+	return applog.FromContextOr(ctx, l.base)
+}
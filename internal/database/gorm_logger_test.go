@@ -0,0 +1,85 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	applog "fut-app/pkg/logger"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func traceSQL() (string, int64) {
+	return "SELECT * FROM players", 3
+}
+
+func TestGormLogger_Trace(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   logger.LogLevel
+		elapsed time.Duration
+		err     error
+		want    string
+	}{
+		{name: "error", level: logger.Error, err: errors.New("syntax error"), want: "level=ERROR msg=\"query failed\""},
+		{name: "record not found is not an error", level: logger.Error, err: gorm.ErrRecordNotFound, want: ""},
+		{name: "slow query", level: logger.Warn, elapsed: 300 * time.Millisecond, want: "level=WARN msg=\"slow query\""},
+		{name: "slow query below warn level", level: logger.Error, elapsed: 300 * time.Millisecond, want: ""},
+		{name: "every query at info", level: logger.Info, want: "level=INFO msg=query"},
+		{name: "fast query at warn", level: logger.Warn, want: ""},
+		{name: "silent", level: logger.Silent, err: errors.New("syntax error"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l := NewGormLogger(slog.New(slog.NewTextHandler(&buf, nil)), tt.level, 200*time.Millisecond)
+
+			l.Trace(context.Background(), time.Now().Add(-tt.elapsed), traceSQL, tt.err)
+
+			out := buf.String()
+			if tt.want == "" {
+				if out != "" {
+					t.Errorf("Trace() logged %q, want nothing", out)
+				}
+				return
+			}
+			if !strings.Contains(out, tt.want) || !strings.Contains(out, `sql="SELECT * FROM players"`) || !strings.Contains(out, "rows=3") {
+				t.Errorf("Trace() logged %q, want %q with sql and rows", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestGormLogger_UsesRequestLogger(t *testing.T) {
+	var base, request bytes.Buffer
+	l := NewGormLogger(slog.New(slog.NewTextHandler(&base, nil)), logger.Info, 0)
+	ctx := applog.WithContext(context.Background(), slog.New(slog.NewTextHandler(&request, nil)).With(slog.String("request_id", "req-7")))
+
+	l.Trace(ctx, time.Now(), traceSQL, nil)
+
+	if !strings.Contains(request.String(), "request_id=req-7") {
+		t.Errorf("request log = %q, want the query with the request ID", request.String())
+	}
+	if base.Len() != 0 {
+		t.Errorf("base log = %q, want nothing", base.String())
+	}
+}
+
+func TestGormLogger_LogMode(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewGormLogger(slog.New(slog.NewTextHandler(&buf, nil)), logger.Silent, 0)
+
+	l.LogMode(logger.Info).Info(context.Background(), "migrating %s", "players")
+	l.Info(context.Background(), "ignored")
+
+	if strings.Count(buf.String(), "msg=") != 1 || !strings.Contains(buf.String(), "migrating players") {
+		t.Errorf("log = %q, want only the message from the Info clone", buf.String())
+	}
+}
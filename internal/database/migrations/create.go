@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Create grava um novo par de arquivos em root/<dialeto> para cada dialeto, todos com a
+// próxima versão da sequência, e devolve os caminhos criados.
+func Create(root, name string) ([]string, error) {
+	slug := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if slug == "" {
+		return nil, fmt.Errorf("invalid migration name %q", name)
+	}
+
+	var version int64 = 1
+	for _, dialect := range Dialects {
+		dir := filepath.Join(root, dialect)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		existing, err := Load(os.DirFS(dir))
+		if err != nil {
+			return nil, err
+		}
+		if n := len(existing); n > 0 && existing[n-1].Version >= version {
+			version = existing[n-1].Version + 1
+		}
+	}
+
+	var created []string
+	for _, dialect := range Dialects {
+		base := filepath.Join(root, dialect, fmt.Sprintf("%04d_%s", version, slug))
+		for _, direction := range []string{"up", "down"} {
+			file := base + "." + direction + ".sql"
+			content := fmt.Sprintf("-- %04d_%s %s (%s)\n", version, slug, direction, dialect)
+			if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+				return created, err
+			}
+			created = append(created, file)
+		}
+	}
+	return created, nil
+}
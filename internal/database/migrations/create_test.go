@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCreate_NextVersionForEachDialect(t *testing.T) {
+	root := t.TempDir()
+
+	created, err := Create(root, "Add players index")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(created) != 2*len(Dialects) {
+		t.Fatalf("Create() = %v, want up and down for each dialect", created)
+	}
+	if filepath.Base(created[0]) != "0001_add_players_index.up.sql" || filepath.Base(created[1]) != "0001_add_players_index.down.sql" {
+		t.Fatalf("Create() = %v", created)
+	}
+
+	// Uma versão a mais só no sqlite empurra a próxima versão de todos os dialetos.
+	extra := filepath.Join(root, "sqlite", "0002_only_sqlite")
+	for _, suffix := range []string{".up.sql", ".down.sql"} {
+		if err := os.WriteFile(extra+suffix, []byte("SELECT 1;"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	created, err = Create(root, "seed")
+	if err != nil {
+		t.Fatalf("Create() second error = %v", err)
+	}
+	if filepath.Base(created[0]) != "0003_seed.up.sql" {
+		t.Errorf("Create() second = %s, want version 3", created[0])
+	}
+
+	for _, dialect := range Dialects {
+		loaded, err := Load(os.DirFS(filepath.Join(root, dialect)))
+		if err != nil || loaded[len(loaded)-1].Version != 3 {
+			t.Errorf("Load(%s) after Create() = %+v, %v", dialect, loaded, err)
+		}
+	}
+}
+
+func TestCreate_InvalidName(t *testing.T) {
+	if _, err := Create(t.TempDir(), "!!!"); err == nil {
+		t.Error("Create() error = nil, want invalid name")
+	}
+}
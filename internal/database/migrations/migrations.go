@@ -0,0 +1,100 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Migration é um passo versionado do schema; Up e Down são scripts SQL que podem
+// conter vários comandos.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+//go:embed sql
+var embedded embed.FS
+
+// DefaultDir é onde `migrate create` grava novos arquivos, relativo à raiz do repositório.
+// Cada dialeto tem seu subdiretório com as mesmas versões.
+const DefaultDir = "internal/database/migrations/sql"
+
+// Dialects são os nomes de dialeto do GORM com migrações embutidas.
+var Dialects = []string{"postgres", "sqlite"}
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Embedded carrega as migrações compiladas no binário para o dialeto informado
+// (db.Dialector.Name()).
+func Embedded(dialect string) ([]Migration, error) {
+	if !slices.Contains(Dialects, dialect) {
+		return nil, fmt.Errorf("no migrations for dialect %q", dialect)
+	}
+	sub, err := fs.Sub(embedded, path.Join("sql", dialect))
+	if err != nil {
+		return nil, err
+	}
+	return Load(sub)
+}
+
+// Load lê os pares NNNN_nome.up.sql / NNNN_nome.down.sql da raiz de fsys, em ordem de versão.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q: want NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %q and %q", version, m.Name, match[2])
+		}
+
+		script := strings.TrimSpace(string(content))
+		if match[3] == "up" {
+			m.Up = script
+		} else {
+			m.Down = script
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs non-empty up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
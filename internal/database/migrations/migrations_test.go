@@ -0,0 +1,178 @@
+package migrations
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad_OrdersByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":   {Data: []byte("CREATE INDEX idx ON t (a);")},
+		"0002_add_index.down.sql": {Data: []byte("DROP INDEX idx;")},
+		"0001_create_t.up.sql":    {Data: []byte("CREATE TABLE t (a INT);")},
+		"0001_create_t.down.sql":  {Data: []byte("DROP TABLE t;")},
+		"README.md":               {Data: []byte("ignored")},
+	}
+
+	got, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Version != 1 || got[1].Version != 2 {
+		t.Fatalf("Load() = %+v", got)
+	}
+	if got[0].Name != "create_t" || got[0].Down != "DROP TABLE t;" {
+		t.Errorf("Load() first migration = %+v", got[0])
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want string
+	}{
+		{
+			name: "missing down",
+			fsys: fstest.MapFS{"0001_create_t.up.sql": {Data: []byte("CREATE TABLE t (a INT);")}},
+			want: "needs non-empty up and down",
+		},
+		{
+			name: "bad name",
+			fsys: fstest.MapFS{"create_t.sql": {Data: []byte("SELECT 1;")}},
+			want: "invalid migration file name",
+		},
+		{
+			name: "two names for one version",
+			fsys: fstest.MapFS{
+				"0001_a.up.sql":   {Data: []byte("SELECT 1;")},
+				"0001_b.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			want: "has two names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbedded_SameVersionsForEachDialect(t *testing.T) {
+	postgres, err := Embedded("postgres")
+	if err != nil {
+		t.Fatalf("Embedded(postgres) error = %v", err)
+	}
+	if len(postgres) == 0 || postgres[0].Version != 1 || postgres[0].Name != "initial" {
+		t.Fatalf("Embedded(postgres) = %+v, want the initial migration first", postgres)
+	}
+
+	sqlite, err := Embedded("sqlite")
+	if err != nil {
+		t.Fatalf("Embedded(sqlite) error = %v", err)
+	}
+	if len(sqlite) != len(postgres) {
+		t.Fatalf("Embedded() has %d sqlite and %d postgres migrations", len(sqlite), len(postgres))
+	}
+	for i := range postgres {
+		if sqlite[i].Version != postgres[i].Version || sqlite[i].Name != postgres[i].Name {
+			t.Errorf("Embedded() migration %d differs: %04d_%s vs %04d_%s", i,
+				sqlite[i].Version, sqlite[i].Name, postgres[i].Version, postgres[i].Name)
+		}
+	}
+}
+
+func TestEmbedded_UnknownDialect(t *testing.T) {
+	if _, err := Embedded("mysql"); err == nil {
+		t.Error("Embedded(mysql) error = nil, want error")
+	}
+}
+
+func TestEmbedded_SQLiteSchema(t *testing.T) {
+	embedded, err := Embedded("sqlite")
+	if err != nil {
+		t.Fatalf("Embedded() error = %v", err)
+	}
+	db := setupMigratorDB(t)
+	m := NewMigrator(db, embedded, slog.Default())
+
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	for _, table := range []string{"players", "positions", "player_positions", "matches", "match_players", "ratings", "accounts", "revoked_tokens", "groups", "group_members", "match_attendances"} {
+		if !tableExists(t, db, table) {
+			t.Errorf("Up() should create %s", table)
+		}
+	}
+	if _, err := m.Down(context.Background(), len(embedded)); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if tableExists(t, db, "players") {
+		t.Error("Down() should drop players")
+	}
+}
+
+func TestEmbedded_SQLitePlayerOverallBackfill(t *testing.T) {
+	embedded, err := Embedded("sqlite")
+	if err != nil {
+		t.Fatalf("Embedded() error = %v", err)
+	}
+	db := setupMigratorDB(t)
+	if _, err := NewMigrator(db, embedded[:1], slog.Default()).Up(context.Background()); err != nil {
+		t.Fatalf("Up() initial error = %v", err)
+	}
+	err = db.Exec(`INSERT INTO players (name, stats) VALUES
+		('Zico', '{"finishing": 90, "passing": 88, "speed": 80, "defense": 55, "stamina": 75, "highlight": 92}'),
+		('Legado', '{"velocidade": 80}')`).Error
+	if err != nil {
+		t.Fatalf("failed to insert players: %v", err)
+	}
+
+	if _, err := NewMigrator(db, embedded, slog.Default()).Up(context.Background()); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	var overall []int
+	if err := db.Raw("SELECT overall FROM players ORDER BY id").Scan(&overall).Error; err != nil {
+		t.Fatalf("failed to read overall: %v", err)
+	}
+	if len(overall) != 2 || overall[0] != 80 || overall[1] != 0 {
+		t.Errorf("overall = %v, want [80 0]", overall)
+	}
+}
+
+func TestEmbedded_SQLiteGroupsBackfill(t *testing.T) {
+	embedded, err := Embedded("sqlite")
+	if err != nil {
+		t.Fatalf("Embedded() error = %v", err)
+	}
+	db := setupMigratorDB(t)
+	if _, err := NewMigrator(db, embedded[:4], slog.Default()).Up(context.Background()); err != nil {
+		t.Fatalf("Up() before groups error = %v", err)
+	}
+	err = db.Exec(`INSERT INTO players (id, name, stats) VALUES (1, 'Zico', '{}'), (2, 'Convidado', '{}')`).Error
+	if err == nil {
+		err = db.Exec(`INSERT INTO accounts (email, password_hash, player_id, role) VALUES ('zico@fut.app', 'hash', 1, 'organiser')`).Error
+	}
+	if err != nil {
+		t.Fatalf("failed to insert data: %v", err)
+	}
+
+	if _, err := NewMigrator(db, embedded, slog.Default()).Up(context.Background()); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	var orphans int64
+	if err := db.Raw("SELECT COUNT(*) FROM players WHERE group_id IS NULL").Scan(&orphans).Error; err != nil || orphans != 0 {
+		t.Errorf("players without group = %d, %v, want 0", orphans, err)
+	}
+	var role string
+	if err := db.Raw("SELECT role FROM group_members WHERE player_id = 1").Scan(&role).Error; err != nil || role != "organiser" {
+		t.Errorf("membership role = %q, %v, want the organiser account kept as group organiser", role, err)
+	}
+}
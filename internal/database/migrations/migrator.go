@@ -0,0 +1,222 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrSchemaBehind indica que existem migrações embutidas que ainda não foram aplicadas.
+var ErrSchemaBehind = errors.New("database schema is behind")
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMP NOT NULL
+)`
+
+type (
+	Migrator struct {
+		db         *gorm.DB
+		migrations []Migration
+		logger     *slog.Logger
+	}
+
+	// Status é a situação de uma migração; AppliedAt é nil enquanto ela estiver pendente.
+	Status struct {
+		Version   int64
+		Name      string
+		AppliedAt *time.Time
+	}
+
+	schemaMigration struct {
+		Version   int64 `gorm:"primaryKey;autoIncrement:false"`
+		Name      string
+		AppliedAt time.Time
+	}
+)
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+func NewMigrator(db *gorm.DB, migrations []Migration, l *slog.Logger) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     l,
+	}
+}
+
+// Up aplica as migrações pendentes em ordem, cada uma em sua própria transação, e
+// devolve as que foram aplicadas.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range pending {
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %04d_%s up: %w", migration.Version, migration.Name, err)
+		}
+		m.logger.Info("migration applied", slog.Int64("version", migration.Version), slog.String("name", migration.Name))
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+// Down reverte as últimas steps migrações aplicadas, da mais recente para a mais antiga.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var reverted []Migration
+	for i := len(applied) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration, ok := byVersion[applied[i].Version]
+		if !ok {
+			return reverted, fmt.Errorf("migration %d is applied but unknown to this binary", applied[i].Version)
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&schemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("migration %04d_%s down: %w", migration.Version, migration.Name, err)
+		}
+		m.logger.Info("migration reverted", slog.Int64("version", migration.Version), slog.String("name", migration.Name))
+		reverted = append(reverted, migration)
+	}
+	return reverted, nil
+}
+
+// Status lista todas as migrações conhecidas com a data em que foram aplicadas.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appliedAt := make(map[int64]time.Time, len(applied))
+	for _, a := range applied {
+		appliedAt[a.Version] = a.AppliedAt
+	}
+
+	status := make([]Status, len(m.migrations))
+	for i, migration := range m.migrations {
+		status[i] = Status{Version: migration.Version, Name: migration.Name}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status[i].AppliedAt = &at
+		}
+	}
+	return status, nil
+}
+
+// Pending devolve as migrações ainda não aplicadas, em ordem de versão.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[int64]bool, len(applied))
+	for _, a := range applied {
+		done[a.Version] = true
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if !done[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// CheckCurrent retorna ErrSchemaBehind quando há migrações pendentes.
+func (m *Migrator) CheckCurrent(ctx context.Context) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending migrations, starting at %04d_%s", ErrSchemaBehind, len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
+
+// HealthCheck informa a última versão aplicada e a última embutida; falha com
+// ErrSchemaBehind quando há migrações pendentes. Roda a cada probe, então só lê: sem
+// schema_migrations, o banco conta como não migrado em vez de ganhar a tabela.
+func (m *Migrator) HealthCheck(ctx context.Context) (map[string]any, error) {
+	var applied []schemaMigration
+	missing := !m.db.WithContext(ctx).Migrator().HasTable(schemaMigration{})
+	if !missing {
+		var err error
+		if applied, err = m.list(ctx); err != nil {
+			return nil, err
+		}
+	}
+	done := make(map[int64]bool, len(applied))
+	var current int64
+	for _, a := range applied {
+		done[a.Version] = true
+		current = max(current, a.Version)
+	}
+
+	var latest int64
+	pending := 0
+	for _, migration := range m.migrations {
+		latest = max(latest, migration.Version)
+		if !done[migration.Version] {
+			pending++
+		}
+	}
+
+	details := map[string]any{"version": current, "latest": latest, "pending": pending}
+	if missing {
+		return details, fmt.Errorf("%w: schema_migrations missing", ErrSchemaBehind)
+	}
+	if pending > 0 {
+		return details, fmt.Errorf("%w: %d pending migrations", ErrSchemaBehind, pending)
+	}
+	return details, nil
+}
+
+// applied cria schema_migrations na primeira execução antes de listar as versões.
+func (m *Migrator) applied(ctx context.Context) ([]schemaMigration, error) {
+	if err := m.db.WithContext(ctx).Exec(createSchemaMigrations).Error; err != nil {
+		return nil, fmt.Errorf("creating schema_migrations: %w", err)
+	}
+	return m.list(ctx)
+}
+
+func (m *Migrator) list(ctx context.Context) ([]schemaMigration, error) {
+	var applied []schemaMigration
+	if err := m.db.WithContext(ctx).Order("version").Find(&applied).Error; err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
@@ -0,0 +1,166 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupMigratorDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql.DB: %v", err)
+	}
+	// Cada conexão :memory: é um banco diferente.
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Name: "create_teams", Up: "CREATE TABLE teams (id INTEGER PRIMARY KEY, name TEXT NOT NULL);", Down: "DROP TABLE teams;"},
+		{Version: 2, Name: "add_team_index", Up: "CREATE UNIQUE INDEX idx_teams_name ON teams (name);", Down: "DROP INDEX idx_teams_name;"},
+	}
+}
+
+func tableExists(t *testing.T, db *gorm.DB, name string) bool {
+	var count int64
+	if err := db.Raw("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", name).Scan(&count).Error; err != nil {
+		t.Fatalf("failed to query sqlite_master: %v", err)
+	}
+	return count > 0
+}
+
+func TestMigrator_UpAndStatus(t *testing.T) {
+	db := setupMigratorDB(t)
+	m := NewMigrator(db, testMigrations(), slog.Default())
+	ctx := context.Background()
+
+	if err := m.CheckCurrent(ctx); !errors.Is(err, ErrSchemaBehind) {
+		t.Fatalf("CheckCurrent() before up error = %v, want ErrSchemaBehind", err)
+	}
+
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if len(applied) != 2 || !tableExists(t, db, "teams") {
+		t.Fatalf("Up() applied = %+v", applied)
+	}
+
+	again, err := m.Up(ctx)
+	if err != nil || len(again) != 0 {
+		t.Fatalf("Up() second run = %+v, %v, want nothing to apply", again, err)
+	}
+	if err := m.CheckCurrent(ctx); err != nil {
+		t.Errorf("CheckCurrent() after up error = %v", err)
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	for _, s := range status {
+		if s.AppliedAt == nil {
+			t.Errorf("Status() %d should be applied", s.Version)
+		}
+	}
+}
+
+func TestMigrator_HealthCheck(t *testing.T) {
+	db := setupMigratorDB(t)
+	m := NewMigrator(db, testMigrations(), slog.Default())
+	ctx := context.Background()
+
+	details, err := m.HealthCheck(ctx)
+	if !errors.Is(err, ErrSchemaBehind) {
+		t.Fatalf("HealthCheck() before up error = %v, want ErrSchemaBehind", err)
+	}
+	if details["version"] != int64(0) || details["latest"] != int64(2) || details["pending"] != 2 {
+		t.Errorf("HealthCheck() before up = %v", details)
+	}
+	if db.Migrator().HasTable("schema_migrations") {
+		t.Error("HealthCheck() created schema_migrations, want a read-only check")
+	}
+
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	details, err = m.HealthCheck(ctx)
+	if err != nil {
+		t.Fatalf("HealthCheck() after up error = %v", err)
+	}
+	if details["version"] != int64(2) || details["pending"] != 0 {
+		t.Errorf("HealthCheck() after up = %v", details)
+	}
+}
+
+func TestMigrator_Down(t *testing.T) {
+	db := setupMigratorDB(t)
+	m := NewMigrator(db, testMigrations(), slog.Default())
+	ctx := context.Background()
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	reverted, err := m.Down(ctx, 1)
+	if err != nil || len(reverted) != 1 || reverted[0].Version != 2 {
+		t.Fatalf("Down(1) = %+v, %v, want version 2", reverted, err)
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil || len(pending) != 1 || pending[0].Version != 2 {
+		t.Fatalf("Pending() = %+v, %v, want version 2", pending, err)
+	}
+
+	if _, err := m.Down(ctx, 5); err != nil {
+		t.Fatalf("Down(5) error = %v", err)
+	}
+	if tableExists(t, db, "teams") {
+		t.Error("Down() should drop the teams table")
+	}
+
+	status, _ := m.Status(ctx)
+	for _, s := range status {
+		if s.AppliedAt != nil {
+			t.Errorf("Status() %d should be pending after down", s.Version)
+		}
+	}
+}
+
+func TestMigrator_Up_RollsBackFailedMigration(t *testing.T) {
+	db := setupMigratorDB(t)
+	broken := append(testMigrations(), Migration{Version: 3, Name: "broken", Up: "CREATE TABLE players (id INTEGER PRIMARY KEY); INSERT INTO nowhere VALUES (1);", Down: "DROP TABLE players;"})
+	m := NewMigrator(db, broken, slog.Default())
+
+	applied, err := m.Up(context.Background())
+	if err == nil {
+		t.Fatal("Up() error = nil, want failure on migration 3")
+	}
+	if len(applied) != 2 {
+		t.Errorf("Up() applied %d migrations before failing, want 2", len(applied))
+	}
+	if tableExists(t, db, "players") {
+		t.Error("Up() should roll back the failed migration")
+	}
+}
+
+func TestMigrator_Down_UnknownVersion(t *testing.T) {
+	db := setupMigratorDB(t)
+	ctx := context.Background()
+	if _, err := NewMigrator(db, testMigrations(), slog.Default()).Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	older := NewMigrator(db, testMigrations()[:1], slog.Default())
+	if _, err := older.Down(ctx, 1); err == nil {
+		t.Error("Down() error = nil, want unknown migration error")
+	}
+}
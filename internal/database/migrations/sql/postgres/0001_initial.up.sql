@@ -0,0 +1,88 @@
+-- Schema equivalente ao que o AutoMigrate criava. Usa IF NOT EXISTS para que bancos
+-- criados pelo AutoMigrate possam registrar esta versão sem erro.
+
+CREATE TABLE IF NOT EXISTS players (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(100) NOT NULL,
+    stats JSONB DEFAULT '{}'
+);
+CREATE INDEX IF NOT EXISTS idx_players_deleted_at ON players (deleted_at);
+
+CREATE TABLE IF NOT EXISTS positions (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(50) NOT NULL,
+    abbreviation VARCHAR(5),
+    line VARCHAR(20),
+    CONSTRAINT chk_positions_line CHECK (line IN ('goal', 'defense', 'midfield', 'attack'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_positions_name ON positions (name);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_positions_abbreviation ON positions (abbreviation);
+CREATE INDEX IF NOT EXISTS idx_positions_deleted_at ON positions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS player_positions (
+    player_id BIGINT NOT NULL,
+    position_id BIGINT NOT NULL,
+    PRIMARY KEY (player_id, position_id),
+    CONSTRAINT fk_player_positions_player FOREIGN KEY (player_id) REFERENCES players (id),
+    CONSTRAINT fk_player_positions_position FOREIGN KEY (position_id) REFERENCES positions (id)
+);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    date TIMESTAMPTZ NOT NULL,
+    venue VARCHAR(150) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'scheduled',
+    home_team_name VARCHAR(50) NOT NULL DEFAULT '',
+    away_team_name VARCHAR(50) NOT NULL DEFAULT '',
+    home_score BIGINT,
+    away_score BIGINT,
+    CONSTRAINT chk_matches_home_score CHECK (home_score >= 0),
+    CONSTRAINT chk_matches_away_score CHECK (away_score >= 0)
+);
+CREATE INDEX IF NOT EXISTS idx_matches_status ON matches (status);
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches (deleted_at);
+
+CREATE TABLE IF NOT EXISTS match_players (
+    match_id BIGINT NOT NULL,
+    player_id BIGINT NOT NULL,
+    team VARCHAR(10) NOT NULL,
+    PRIMARY KEY (match_id, player_id),
+    CONSTRAINT fk_matches_players FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_match_players_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS ratings (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    match_id BIGINT NOT NULL,
+    player_id BIGINT NOT NULL,
+    rated_player_id BIGINT NOT NULL,
+    finishing BIGINT,
+    passing BIGINT,
+    speed BIGINT,
+    defense BIGINT,
+    stamina BIGINT,
+    highlight BIGINT,
+    CONSTRAINT chk_ratings_finishing CHECK (finishing BETWEEN 45 AND 99),
+    CONSTRAINT chk_ratings_passing CHECK (passing BETWEEN 45 AND 99),
+    CONSTRAINT chk_ratings_speed CHECK (speed BETWEEN 45 AND 99),
+    CONSTRAINT chk_ratings_defense CHECK (defense BETWEEN 45 AND 99),
+    CONSTRAINT chk_ratings_stamina CHECK (stamina BETWEEN 45 AND 99),
+    CONSTRAINT chk_ratings_highlight CHECK (highlight BETWEEN 45 AND 99)
+);
+CREATE INDEX IF NOT EXISTS idx_ratings_match_id ON ratings (match_id);
+CREATE INDEX IF NOT EXISTS idx_ratings_player_id ON ratings (player_id);
+CREATE INDEX IF NOT EXISTS idx_ratings_rated_player_id ON ratings (rated_player_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ratings_match_rater_rated ON ratings (match_id, player_id, rated_player_id);
+CREATE INDEX IF NOT EXISTS idx_ratings_deleted_at ON ratings (deleted_at);
@@ -0,0 +1,13 @@
+ALTER TABLE players ADD COLUMN IF NOT EXISTS overall INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_players_overall ON players (overall);
+
+-- Preenche as linhas existentes com a média simples dos atributos; a próxima gravação
+-- do jogador aplica os pesos do setor.
+UPDATE players SET overall = ROUND((
+    CASE WHEN jsonb_typeof(stats->'finishing') = 'number' THEN (stats->>'finishing')::numeric ELSE 0 END +
+    CASE WHEN jsonb_typeof(stats->'passing') = 'number' THEN (stats->>'passing')::numeric ELSE 0 END +
+    CASE WHEN jsonb_typeof(stats->'speed') = 'number' THEN (stats->>'speed')::numeric ELSE 0 END +
+    CASE WHEN jsonb_typeof(stats->'defense') = 'number' THEN (stats->>'defense')::numeric ELSE 0 END +
+    CASE WHEN jsonb_typeof(stats->'stamina') = 'number' THEN (stats->>'stamina')::numeric ELSE 0 END +
+    CASE WHEN jsonb_typeof(stats->'highlight') = 'number' THEN (stats->>'highlight')::numeric ELSE 0 END
+) / 6);
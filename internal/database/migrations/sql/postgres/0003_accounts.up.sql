@@ -0,0 +1,24 @@
+-- Contas de acesso: cada jogador tem no máximo uma conta. O e-mail é gravado em
+-- minúsculas, então o índice único já impede duplicatas com maiúsculas diferentes.
+CREATE TABLE IF NOT EXISTS accounts (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    player_id BIGINT NOT NULL,
+    email VARCHAR(254) NOT NULL,
+    password_hash VARCHAR(100) NOT NULL,
+    CONSTRAINT fk_accounts_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_player_id ON accounts (player_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_email ON accounts (email);
+CREATE INDEX IF NOT EXISTS idx_accounts_deleted_at ON accounts (deleted_at);
+
+-- Tokens revogados no logout ou trocados no refresh. A linha só precisa existir até o
+-- token expirar; depois disso a assinatura já o rejeita.
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
@@ -0,0 +1,23 @@
+-- Volta a ligar cada conta a um único jogador: fica o da adesão mais antiga, e contas sem
+-- nenhum grupo são removidas. Jogadores, partidas e notas de todos os grupos se misturam.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS player_id BIGINT;
+UPDATE accounts SET player_id = (
+    SELECT group_members.player_id FROM group_members
+    WHERE group_members.account_id = accounts.id
+    ORDER BY group_members.id LIMIT 1
+);
+DELETE FROM accounts WHERE player_id IS NULL;
+ALTER TABLE accounts ALTER COLUMN player_id SET NOT NULL;
+ALTER TABLE accounts ADD CONSTRAINT fk_accounts_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_player_id ON accounts (player_id);
+
+DROP TABLE IF EXISTS group_members;
+
+DROP INDEX IF EXISTS idx_ratings_group_id;
+DROP INDEX IF EXISTS idx_matches_group_id;
+DROP INDEX IF EXISTS idx_players_group_id;
+ALTER TABLE ratings DROP COLUMN IF EXISTS group_id;
+ALTER TABLE matches DROP COLUMN IF EXISTS group_id;
+ALTER TABLE players DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS groups;
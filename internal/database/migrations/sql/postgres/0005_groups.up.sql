@@ -0,0 +1,60 @@
+-- Grupos (peladas) passam a ser o escopo de jogadores, partidas e notas. O jogador vira a
+-- ficha de uma pessoa dentro de um grupo: quem joga em dois grupos tem dois jogadores, cada
+-- um com suas notas. A ligação entre conta e jogador sai de accounts e vai para
+-- group_members, que também guarda o papel da conta no grupo.
+CREATE TABLE IF NOT EXISTS groups (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name VARCHAR(100) NOT NULL,
+    join_code VARCHAR(16) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_groups_join_code ON groups (join_code);
+CREATE INDEX IF NOT EXISTS idx_groups_deleted_at ON groups (deleted_at);
+
+-- Os dados que já existem vão para um grupo único.
+INSERT INTO groups (created_at, updated_at, name, join_code)
+SELECT NOW(), NOW(), 'Pelada', UPPER(SUBSTR(MD5(RANDOM()::TEXT), 1, 8))
+WHERE EXISTS (SELECT 1 FROM players) OR EXISTS (SELECT 1 FROM matches);
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS group_id BIGINT;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS group_id BIGINT;
+ALTER TABLE ratings ADD COLUMN IF NOT EXISTS group_id BIGINT;
+UPDATE players SET group_id = (SELECT MIN(id) FROM groups) WHERE group_id IS NULL;
+UPDATE matches SET group_id = (SELECT MIN(id) FROM groups) WHERE group_id IS NULL;
+UPDATE ratings SET group_id = (SELECT MIN(id) FROM groups) WHERE group_id IS NULL;
+ALTER TABLE players ALTER COLUMN group_id SET NOT NULL;
+ALTER TABLE matches ALTER COLUMN group_id SET NOT NULL;
+ALTER TABLE ratings ALTER COLUMN group_id SET NOT NULL;
+ALTER TABLE players ADD CONSTRAINT fk_players_group FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE;
+ALTER TABLE matches ADD CONSTRAINT fk_matches_group FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE;
+ALTER TABLE ratings ADD CONSTRAINT fk_ratings_group FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_players_group_id ON players (group_id);
+CREATE INDEX IF NOT EXISTS idx_matches_group_id ON matches (group_id);
+CREATE INDEX IF NOT EXISTS idx_ratings_group_id ON ratings (group_id);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    group_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    player_id BIGINT NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'player',
+    CONSTRAINT fk_group_members_group FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_members_account FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_members_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_members_group_account ON group_members (group_id, account_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_members_player_id ON group_members (player_id);
+CREATE INDEX IF NOT EXISTS idx_group_members_account_id ON group_members (account_id);
+
+-- Organizadores continuam organizando o grupo em que seus jogadores já estavam.
+INSERT INTO group_members (created_at, updated_at, group_id, account_id, player_id, role)
+SELECT NOW(), NOW(), players.group_id, accounts.id, accounts.player_id,
+       CASE WHEN accounts.role = 'organiser' THEN 'organiser' ELSE 'player' END
+FROM accounts JOIN players ON players.id = accounts.player_id;
+
+DROP INDEX IF EXISTS idx_accounts_player_id;
+ALTER TABLE accounts DROP COLUMN IF EXISTS player_id;
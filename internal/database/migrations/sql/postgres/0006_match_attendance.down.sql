@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS match_attendances;
+ALTER TABLE matches DROP COLUMN IF EXISTS rsvp_deadline;
+ALTER TABLE matches DROP COLUMN IF EXISTS max_players;
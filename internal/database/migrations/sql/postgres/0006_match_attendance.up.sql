@@ -0,0 +1,19 @@
+-- Lista de presença das partidas: limite de confirmados, prazo para responder e a resposta
+-- de cada jogador, com a marcação de quem confirmou e não apareceu.
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS max_players INTEGER NOT NULL DEFAULT 0 CONSTRAINT chk_matches_max_players CHECK (max_players >= 0);
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS rsvp_deadline TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS match_attendances (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    match_id BIGINT NOT NULL,
+    player_id BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    responded_at TIMESTAMPTZ NOT NULL,
+    no_show BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_match_attendances_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_match_attendances_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_match_attendances_match_player ON match_attendances (match_id, player_id);
+CREATE INDEX IF NOT EXISTS idx_match_attendances_player_id ON match_attendances (player_id);
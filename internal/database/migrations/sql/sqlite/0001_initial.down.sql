@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS ratings;
+DROP TABLE IF EXISTS match_players;
+DROP TABLE IF EXISTS matches;
+DROP TABLE IF EXISTS player_positions;
+DROP TABLE IF EXISTS positions;
+DROP TABLE IF EXISTS players;
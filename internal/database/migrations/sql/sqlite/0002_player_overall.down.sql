@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_players_overall;
+ALTER TABLE players DROP COLUMN overall;
@@ -0,0 +1,13 @@
+ALTER TABLE players ADD COLUMN overall INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_players_overall ON players (overall);
+
+-- Preenche as linhas existentes com a média simples dos atributos; a próxima gravação
+-- do jogador aplica os pesos do setor.
+UPDATE players SET overall = CAST(ROUND((
+    CASE WHEN json_type(stats, '$.finishing') IN ('integer', 'real') THEN json_extract(stats, '$.finishing') ELSE 0 END +
+    CASE WHEN json_type(stats, '$.passing') IN ('integer', 'real') THEN json_extract(stats, '$.passing') ELSE 0 END +
+    CASE WHEN json_type(stats, '$.speed') IN ('integer', 'real') THEN json_extract(stats, '$.speed') ELSE 0 END +
+    CASE WHEN json_type(stats, '$.defense') IN ('integer', 'real') THEN json_extract(stats, '$.defense') ELSE 0 END +
+    CASE WHEN json_type(stats, '$.stamina') IN ('integer', 'real') THEN json_extract(stats, '$.stamina') ELSE 0 END +
+    CASE WHEN json_type(stats, '$.highlight') IN ('integer', 'real') THEN json_extract(stats, '$.highlight') ELSE 0 END
+) / 6.0) AS INTEGER);
@@ -0,0 +1,35 @@
+-- Mesmo efeito de sql/postgres/0005_groups.down.sql: cada conta volta ao jogador da adesão
+-- mais antiga e contas sem grupo são removidas.
+CREATE TABLE accounts_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    player_id INTEGER NOT NULL,
+    email VARCHAR(254) NOT NULL,
+    password_hash VARCHAR(100) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'player',
+    CONSTRAINT fk_accounts_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+INSERT INTO accounts_old (id, created_at, updated_at, deleted_at, player_id, email, password_hash, role)
+SELECT accounts.id, accounts.created_at, accounts.updated_at, accounts.deleted_at,
+       (SELECT group_members.player_id FROM group_members WHERE group_members.account_id = accounts.id ORDER BY group_members.id LIMIT 1),
+       accounts.email, accounts.password_hash, accounts.role
+FROM accounts
+WHERE EXISTS (SELECT 1 FROM group_members WHERE group_members.account_id = accounts.id);
+
+DROP TABLE IF EXISTS group_members;
+DROP TABLE accounts;
+ALTER TABLE accounts_old RENAME TO accounts;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_player_id ON accounts (player_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_email ON accounts (email);
+CREATE INDEX IF NOT EXISTS idx_accounts_deleted_at ON accounts (deleted_at);
+
+DROP INDEX IF EXISTS idx_ratings_group_id;
+DROP INDEX IF EXISTS idx_matches_group_id;
+DROP INDEX IF EXISTS idx_players_group_id;
+ALTER TABLE ratings DROP COLUMN group_id;
+ALTER TABLE matches DROP COLUMN group_id;
+ALTER TABLE players DROP COLUMN group_id;
+
+DROP TABLE IF EXISTS groups;
@@ -0,0 +1,70 @@
+-- Mesmo schema de sql/postgres/0005_groups.up.sql com os tipos do SQLite. O SQLite não muda a
+-- nulidade de uma coluna existente, então group_id fica anulável nas tabelas antigas; a
+-- aplicação sempre o preenche.
+CREATE TABLE IF NOT EXISTS groups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    name VARCHAR(100) NOT NULL,
+    join_code VARCHAR(16) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_groups_join_code ON groups (join_code);
+CREATE INDEX IF NOT EXISTS idx_groups_deleted_at ON groups (deleted_at);
+
+INSERT INTO groups (created_at, updated_at, name, join_code)
+SELECT CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, 'Pelada', HEX(RANDOMBLOB(4))
+WHERE EXISTS (SELECT 1 FROM players) OR EXISTS (SELECT 1 FROM matches);
+
+ALTER TABLE players ADD COLUMN group_id INTEGER REFERENCES groups (id) ON DELETE CASCADE;
+ALTER TABLE matches ADD COLUMN group_id INTEGER REFERENCES groups (id) ON DELETE CASCADE;
+ALTER TABLE ratings ADD COLUMN group_id INTEGER REFERENCES groups (id) ON DELETE CASCADE;
+UPDATE players SET group_id = (SELECT MIN(id) FROM groups) WHERE group_id IS NULL;
+UPDATE matches SET group_id = (SELECT MIN(id) FROM groups) WHERE group_id IS NULL;
+UPDATE ratings SET group_id = (SELECT MIN(id) FROM groups) WHERE group_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_players_group_id ON players (group_id);
+CREATE INDEX IF NOT EXISTS idx_matches_group_id ON matches (group_id);
+CREATE INDEX IF NOT EXISTS idx_ratings_group_id ON ratings (group_id);
+
+-- DROP COLUMN não remove coluna com foreign key no SQLite: accounts é recriada sem
+-- player_id, guardando antes a ligação para preencher group_members.
+CREATE TABLE account_players (account_id INTEGER PRIMARY KEY, player_id INTEGER NOT NULL, role VARCHAR(20) NOT NULL);
+INSERT INTO account_players (account_id, player_id, role) SELECT id, player_id, role FROM accounts;
+
+CREATE TABLE accounts_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    deleted_at DATETIME,
+    email VARCHAR(254) NOT NULL,
+    password_hash VARCHAR(100) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'player'
+);
+INSERT INTO accounts_new (id, created_at, updated_at, deleted_at, email, password_hash, role)
+SELECT id, created_at, updated_at, deleted_at, email, password_hash, role FROM accounts;
+DROP TABLE accounts;
+ALTER TABLE accounts_new RENAME TO accounts;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_email ON accounts (email);
+CREATE INDEX IF NOT EXISTS idx_accounts_deleted_at ON accounts (deleted_at);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    group_id INTEGER NOT NULL,
+    account_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'player',
+    CONSTRAINT fk_group_members_group FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_members_account FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE CASCADE,
+    CONSTRAINT fk_group_members_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_members_group_account ON group_members (group_id, account_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_members_player_id ON group_members (player_id);
+CREATE INDEX IF NOT EXISTS idx_group_members_account_id ON group_members (account_id);
+
+INSERT INTO group_members (created_at, updated_at, group_id, account_id, player_id, role)
+SELECT CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, players.group_id, account_players.account_id, account_players.player_id,
+       CASE WHEN account_players.role = 'organiser' THEN 'organiser' ELSE 'player' END
+FROM account_players JOIN players ON players.id = account_players.player_id;
+DROP TABLE account_players;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS match_attendances;
+ALTER TABLE matches DROP COLUMN rsvp_deadline;
+ALTER TABLE matches DROP COLUMN max_players;
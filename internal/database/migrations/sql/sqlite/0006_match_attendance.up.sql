@@ -0,0 +1,18 @@
+-- Mesmo schema de sql/postgres/0006_match_attendance.up.sql com os tipos do SQLite.
+ALTER TABLE matches ADD COLUMN max_players INTEGER NOT NULL DEFAULT 0 CONSTRAINT chk_matches_max_players CHECK (max_players >= 0);
+ALTER TABLE matches ADD COLUMN rsvp_deadline DATETIME;
+
+CREATE TABLE IF NOT EXISTS match_attendances (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at DATETIME,
+    updated_at DATETIME,
+    match_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    responded_at DATETIME NOT NULL,
+    no_show NUMERIC NOT NULL DEFAULT false,
+    CONSTRAINT fk_match_attendances_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    CONSTRAINT fk_match_attendances_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_match_attendances_match_player ON match_attendances (match_id, player_id);
+CREATE INDEX IF NOT EXISTS idx_match_attendances_player_id ON match_attendances (player_id);
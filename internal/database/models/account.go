@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"fut-app/internal/database"
+)
+
+// Account é o acesso de uma pessoa à API; PasswordHash guarda o hash bcrypt da senha.
+type Account struct {
+	database.Model
+	Email        string `gorm:"type:varchar(254);not null;uniqueIndex"`
+	PasswordHash string `gorm:"type:varchar(100);not null"`
+	Role         string `gorm:"type:varchar(20);not null;default:player"`
+}
+
+// RevokedToken marca um jti como inválido até o token expirar.
+type RevokedToken struct {
+	JTI       string    `gorm:"column:jti;type:varchar(64);primaryKey"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	CreatedAt time.Time
+}
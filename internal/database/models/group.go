@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"fut-app/internal/database"
+)
+
+// Group é uma pelada; JoinCode é o convite para entrar nela.
+type Group struct {
+	database.Model
+	Name     string `gorm:"type:varchar(100);not null"`
+	JoinCode string `gorm:"type:varchar(16);not null;uniqueIndex"`
+}
+
+// GroupMember liga a conta ao jogador dela no grupo. Não tem soft delete para que o índice
+// único de grupo e conta não esbarre em linhas apagadas.
+type GroupMember struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	GroupID   uint    `gorm:"not null;uniqueIndex:idx_group_members_group_account"`
+	AccountID uint    `gorm:"not null;uniqueIndex:idx_group_members_group_account;index"`
+	PlayerID  uint    `gorm:"not null;uniqueIndex"`
+	Role      string  `gorm:"type:varchar(20);not null;default:player"`
+	Group     Group   `gorm:"constraint:OnDelete:CASCADE;"`
+	Account   Account `gorm:"constraint:OnDelete:CASCADE;"`
+	Player    Player  `gorm:"constraint:OnDelete:CASCADE;"`
+}
@@ -12,11 +12,15 @@ func (j *JSONB) Value() (driver.Value, error) {
 	return json.Marshal(j)
 }
 
+// Scan aceita []byte (Postgres e valores gravados pelo app no SQLite) e string (o
+// DEFAULT '{}' no SQLite, que é guardado como texto).
 func (j *JSONB) Scan(value interface{}) error {
-	bytes, ok := value.([]byte)
-	if !ok {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, j)
+	case string:
+		return json.Unmarshal([]byte(v), j)
+	default:
 		return fmt.Errorf("failed to convert %v to JSONB", value)
 	}
-
-	return json.Unmarshal(bytes, j)
 }
@@ -8,5 +8,43 @@ import (
 
 type Match struct {
 	database.Model
-	Date time.Time `gorm:"not null"`
+	GroupID      uint          `gorm:"not null;index"`
+	Date         time.Time     `gorm:"not null"`
+	Venue        string        `gorm:"type:varchar(150);not null;default:''"`
+	Status       string        `gorm:"type:varchar(20);not null;default:'scheduled';index"`
+	HomeTeamName string        `gorm:"type:varchar(50);not null;default:''"`
+	AwayTeamName string        `gorm:"type:varchar(50);not null;default:''"`
+	HomeScore    *int          `gorm:"check:home_score >= 0"`
+	AwayScore    *int          `gorm:"check:away_score >= 0"`
+	MaxPlayers   int           `gorm:"not null;default:0;check:max_players >= 0"`
+	Players      []MatchPlayer `gorm:"constraint:OnDelete:CASCADE;"`
+	RSVPDeadline *time.Time
 }
+
+// MatchPlayer é a escalação de um jogador em um dos dois times da partida.
+type MatchPlayer struct {
+	MatchID  uint   `gorm:"primaryKey"`
+	PlayerID uint   `gorm:"primaryKey"`
+	Team     string `gorm:"type:varchar(10);not null"`
+	Player   Player `gorm:"constraint:OnDelete:CASCADE;"`
+}
+
+// MatchAttendance é a resposta do jogador à convocação da partida. Não tem soft delete para
+// que o índice único de partida e jogador não esbarre em linhas apagadas.
+type MatchAttendance struct {
+	ID          uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	MatchID     uint      `gorm:"not null;uniqueIndex:idx_match_attendances_match_player"`
+	PlayerID    uint      `gorm:"not null;uniqueIndex:idx_match_attendances_match_player;index"`
+	Status      string    `gorm:"type:varchar(20);not null"`
+	RespondedAt time.Time `gorm:"not null"`
+	NoShow      bool      `gorm:"not null;default:false"`
+	Match       Match     `gorm:"constraint:OnDelete:CASCADE;"`
+	Player      Player    `gorm:"constraint:OnDelete:CASCADE;"`
+}
+
+const (
+	HomeTeam = "home"
+	AwayTeam = "away"
+)
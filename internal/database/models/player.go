@@ -6,12 +6,20 @@ import (
 
 type Player struct {
 	database.Model
+	GroupID  uint       `gorm:"not null;index"`
 	Name     string     `gorm:"type:varchar(100);not null"`
 	Position []Position `gorm:"many2many:player_positions;"`
 	Stats    *JSONB     `gorm:"type:jsonb;default:'{}'"`
+	// Overall é derivado dos stats e do setor da primeira posição; fica em coluna para
+	// ordenar a listagem no banco.
+	Overall int `gorm:"not null;default:0;index"`
 }
 
+// Position guarda sigla e setor como nulos para não quebrar linhas criadas antes do
+// catálogo; o seed preenche as posições padrão.
 type Position struct {
 	database.Model
-	Name string `gorm:"type:varchar(50);not null;uniqueIndex"`
+	Name         string  `gorm:"type:varchar(50);not null;uniqueIndex"`
+	Abbreviation *string `gorm:"type:varchar(5);uniqueIndex"`
+	Line         *string `gorm:"type:varchar(20);check:line IN ('goal', 'defense', 'midfield', 'attack')"`
 }
@@ -2,11 +2,13 @@ package models
 
 import "fut-app/internal/database"
 
+// Rating é a nota que PlayerID deu a RatedPlayerID na partida MatchID.
 type Rating struct {
 	database.Model
-	MatchID       uint `gorm:"not null;index"`
-	PlayerID      uint `gorm:"not null;index"`
-	RatedPlayerID uint `gorm:"not null;index"`
+	GroupID       uint `gorm:"not null;index"`
+	MatchID       uint `gorm:"not null;index;uniqueIndex:idx_ratings_match_rater_rated"`
+	PlayerID      uint `gorm:"not null;index;uniqueIndex:idx_ratings_match_rater_rated"`
+	RatedPlayerID uint `gorm:"not null;index;uniqueIndex:idx_ratings_match_rater_rated"`
 	Finishing     int  `gorm:"check:finishing BETWEEN 45 AND 99"`
 	Passing       int  `gorm:"check:passing BETWEEN 45 AND 99"`
 	Speed         int  `gorm:"check:speed BETWEEN 45 AND 99"`
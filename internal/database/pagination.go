@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"fut-app/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type (
+	// QueryOptions é a página pedida pelo cliente. Sort e Filters usam os nomes expostos
+	// na API; só o QuerySpec da listagem sabe quais colunas existem por trás deles.
+	QueryOptions struct {
+		Page     int
+		PageSize int
+		Sort     string
+		Search   string
+		Filters  map[string]string
+	}
+
+	// Filter restringe a consulta pelo valor recebido na query string.
+	Filter func(query *gorm.DB, value string) *gorm.DB
+
+	// QuerySpec é a lista branca de uma listagem: nada fora dela chega ao SQL.
+	QuerySpec struct {
+		// Sortable mapeia o campo da API para a coluna ordenável.
+		Sortable map[string]string
+		// DefaultSort segue o formato de QueryOptions.Sort e vale quando o cliente não ordena.
+		DefaultSort string
+		// Searchable são as colunas de texto consultadas por QueryOptions.Search.
+		Searchable []string
+		Filters    map[string]Filter
+		// Preload é aplicado só na busca da página, não na contagem.
+		Preload     []string
+		MaxPageSize int
+	}
+
+	PaginatedResult[T any] struct {
+		Data       []T
+		Total      int64
+		Page       int
+		PageSize   int
+		TotalPages int
+	}
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Paginate conta e busca uma página de T aplicando busca, filtros e ordenação do spec.
+// Campos de ordenação ou filtros desconhecidos e page_size acima do limite voltam como
+// ValidationErrors. A ordem sempre termina em id para que as páginas não se sobreponham.
+func Paginate[T any](ctx context.Context, db *gorm.DB, opts QueryOptions, spec QuerySpec) (*PaginatedResult[T], error) {
+	var errs errors.ValidationErrors
+
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	limit := spec.MaxPageSize
+	if limit <= 0 {
+		limit = maxPageSize
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = min(defaultPageSize, limit)
+	}
+	if opts.PageSize > limit {
+		errs.Append("page_size", fmt.Sprintf("page_size must be between 1 and %d", limit))
+	}
+
+	sortBy := opts.Sort
+	if sortBy == "" {
+		sortBy = spec.DefaultSort
+	}
+	order := spec.orderBy(sortBy, &errs)
+
+	names := make([]string, 0, len(opts.Filters))
+	for name := range opts.Filters {
+		if _, ok := spec.Filters[name]; !ok {
+			errs.Append(name, fmt.Sprintf("Cannot filter by %s", name))
+			continue
+		}
+		names = append(names, name)
+	}
+	if errs.HasErrors() {
+		return nil, &errs
+	}
+	sort.Strings(names)
+
+	query := db.WithContext(ctx).Model(new(T))
+	query = spec.search(query, opts.Search)
+	for _, name := range names {
+		if value := strings.TrimSpace(opts.Filters[name]); value != "" {
+			query = spec.Filters[name](query, value)
+		}
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	page := query.Session(&gorm.Session{})
+	for _, relation := range spec.Preload {
+		page = page.Preload(relation)
+	}
+	data := []T{}
+	err := page.Order(order).
+		Offset((opts.Page - 1) * opts.PageSize).
+		Limit(opts.PageSize).
+		Find(&data).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaginatedResult[T]{
+		Data:       data,
+		Total:      total,
+		Page:       opts.Page,
+		PageSize:   opts.PageSize,
+		TotalPages: int(math.Max(1, math.Ceil(float64(total)/float64(opts.PageSize)))),
+	}, nil
+}
+
+// orderBy traduz "-overall,name" em colunas do spec; só nomes da lista branca viram SQL.
+func (s QuerySpec) orderBy(sortBy string, errs *errors.ValidationErrors) clause.OrderBy {
+	var order clause.OrderBy
+	hasID := false
+	for _, field := range strings.Split(sortBy, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		desc := strings.HasPrefix(field, "-")
+		name := strings.TrimPrefix(field, "-")
+		column, ok := s.Sortable[name]
+		if !ok {
+			errs.Append("sort", fmt.Sprintf("Cannot sort by %s", name))
+			continue
+		}
+		hasID = hasID || column == "id"
+		order.Columns = append(order.Columns, clause.OrderByColumn{Column: clause.Column{Name: column}, Desc: desc})
+	}
+	if !hasID {
+		order.Columns = append(order.Columns, clause.OrderByColumn{Column: clause.Column{Name: "id"}})
+	}
+	return order
+}
+
+// search procura o termo, sem diferenciar maiúsculas, em qualquer coluna buscável.
+func (s QuerySpec) search(query *gorm.DB, term string) *gorm.DB {
+	term = strings.TrimSpace(term)
+	if term == "" || len(s.Searchable) == 0 {
+		return query
+	}
+	pattern := "%" + likeEscaper.Replace(strings.ToLower(term)) + "%"
+	conditions := make([]string, len(s.Searchable))
+	args := make([]interface{}, len(s.Searchable))
+	for i, column := range s.Searchable {
+		conditions[i] = fmt.Sprintf(`LOWER(%s) LIKE ? ESCAPE '\'`, column)
+		args[i] = pattern
+	}
+	return query.Where(strings.Join(conditions, " OR "), args...)
+}
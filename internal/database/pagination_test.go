@@ -0,0 +1,118 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type paginatedTeam struct {
+	ID    uint
+	Name  string
+	Kind  string
+	Score int
+}
+
+var teamQuery = QuerySpec{
+	Sortable:    map[string]string{"name": "name", "score": "score"},
+	DefaultSort: "name",
+	Searchable:  []string{"name"},
+	Filters: map[string]Filter{
+		"kind": func(query *gorm.DB, value string) *gorm.DB { return query.Where("kind = ?", value) },
+	},
+	MaxPageSize: 5,
+}
+
+func setupPaginationDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&paginatedTeam{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	teams := []paginatedTeam{
+		{Name: "Azul", Kind: "society", Score: 3},
+		{Name: "Branco", Kind: "campo", Score: 7},
+		{Name: "Amarelo", Kind: "society", Score: 7},
+		{Name: "Cem_Por_Cento", Kind: "campo", Score: 1},
+		{Name: "Cemitério", Kind: "campo", Score: 2},
+		{Name: "Vermelho", Kind: "society", Score: 5},
+	}
+	if err := db.Create(&teams).Error; err != nil {
+		t.Fatalf("failed to create teams: %v", err)
+	}
+	return db
+}
+
+func teamNames(result *PaginatedResult[paginatedTeam]) string {
+	names := ""
+	for _, team := range result.Data {
+		names += team.Name + " "
+	}
+	return fmt.Sprintf("%d:%s", result.Total, names)
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name string
+		opts QueryOptions
+		want string
+	}{
+		{"default sort", QueryOptions{PageSize: 3}, "6:Amarelo Azul Branco "},
+		{"second page", QueryOptions{Page: 2, PageSize: 4}, "6:Cemitério Vermelho "},
+		{"descending with tie-breaker", QueryOptions{Sort: "-score,name", PageSize: 3}, "6:Amarelo Branco Vermelho "},
+		{"search is case insensitive", QueryOptions{Search: "AZ"}, "1:Azul "},
+		{"search escapes wildcards", QueryOptions{Search: "m_p"}, "1:Cem_Por_Cento "},
+		{"search and filter", QueryOptions{Search: "a", Filters: map[string]string{"kind": "society"}}, "2:Amarelo Azul "},
+		{"empty filter is ignored", QueryOptions{Filters: map[string]string{"kind": ""}, PageSize: 1}, "6:Amarelo "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Paginate[paginatedTeam](context.Background(), setupPaginationDB(t), tt.opts, teamQuery)
+			if err != nil {
+				t.Fatalf("Paginate() error = %v", err)
+			}
+			if got := teamNames(result); got != tt.want {
+				t.Errorf("Paginate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginate_Metadata(t *testing.T) {
+	result, err := Paginate[paginatedTeam](context.Background(), setupPaginationDB(t), QueryOptions{Page: 2, PageSize: 4}, teamQuery)
+	if err != nil {
+		t.Fatalf("Paginate() error = %v", err)
+	}
+	if result.Page != 2 || result.PageSize != 4 || result.TotalPages != 2 {
+		t.Errorf("Paginate() = %+v", result)
+	}
+}
+
+func TestPaginate_RejectsUnknownFields(t *testing.T) {
+	opts := QueryOptions{
+		Sort:     "name; DROP TABLE paginated_teams,-score",
+		PageSize: 50,
+		Filters:  map[string]string{"owner": "x"},
+	}
+	_, err := Paginate[paginatedTeam](context.Background(), setupPaginationDB(t), opts, teamQuery)
+
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Paginate() error = %v, want ValidationErrors", err)
+	}
+	fields := map[string]bool{}
+	for _, e := range *ve {
+		fields[e.Field] = true
+	}
+	if len(*ve) != 3 || !fields["sort"] || !fields["page_size"] || !fields["owner"] {
+		t.Errorf("Paginate() errors = %v", *ve)
+	}
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+type (
+	accountRepository struct {
+		db     *database.Database
+		logger *slog.Logger
+	}
+	Account interface {
+		CreateAccount(context.Context, domain.Account) (*domain.Account, error)
+		GetAccountByID(context.Context, uint) (*domain.Account, error)
+		GetAccountByEmail(ctx context.Context, email string) (*domain.Account, error)
+		RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+		IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+		SetAccountRole(ctx context.Context, id uint, role domain.Role) (*domain.Account, error)
+	}
+)
+
+func NewAccount(DB *database.Database, l *slog.Logger) Account {
+	return &accountRepository{
+		db:     DB,
+		logger: l,
+	}
+}
+
+func (a *accountRepository) CreateAccount(ctx context.Context, account domain.Account) (*domain.Account, error) {
+	modelAccount := models.Account{
+		Email:        account.Email,
+		PasswordHash: account.PasswordHash,
+		Role:         string(account.Role),
+	}
+	if err := a.db.WithContext(ctx).Create(&modelAccount).Error; err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to create account", err, translated)
+		return nil, translated
+	}
+	return toDomainAccount(modelAccount), nil
+}
+
+func (a *accountRepository) GetAccountByID(ctx context.Context, id uint) (*domain.Account, error) {
+	return a.findAccount(ctx, "accounts.id = ?", id)
+}
+
+func (a *accountRepository) GetAccountByEmail(ctx context.Context, email string) (*domain.Account, error) {
+	return a.findAccount(ctx, "accounts.email = ?", email)
+}
+
+func (a *accountRepository) findAccount(ctx context.Context, query string, arg any) (*domain.Account, error) {
+	var modelAccount models.Account
+	err := a.db.WithContext(ctx).Where(query, arg).First(&modelAccount).Error
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to fetch account", err, translated)
+		return nil, translated
+	}
+	return toDomainAccount(modelAccount), nil
+}
+
+// RevokeToken grava o jti e aproveita para apagar as revogações de tokens já expirados.
+// Revogar de novo o mesmo jti devolve ErrAlreadyExists.
+func (a *accountRepository) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	err := a.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := tx.Where("expires_at < ?", time.Now()).Delete(&models.RevokedToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.RevokedToken{JTI: tokenID, ExpiresAt: expiresAt}).Error
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to revoke token", err, translated)
+		return translated
+	}
+	return nil
+}
+
+func (a *accountRepository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var count int64
+	err := a.db.WithContext(ctx).Model(&models.RevokedToken{}).
+		Where("jti = ?", tokenID).
+		Count(&count).Error
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to check revoked token", err, translated)
+		return false, translated
+	}
+	return count > 0, nil
+}
+
+// SetAccountRole troca o papel da conta e devolve a conta atualizada.
+func (a *accountRepository) SetAccountRole(ctx context.Context, id uint, role domain.Role) (*domain.Account, error) {
+	result := a.db.WithContext(ctx).Model(&models.Account{}).
+		Where("id = ?", id).
+		Update("role", string(role))
+	if result.Error != nil {
+		translated := translateError(result.Error)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to update account role", result.Error, translated)
+		return nil, translated
+	}
+	if result.RowsAffected == 0 {
+		return nil, appErr.ErrNotFound
+	}
+	return a.GetAccountByID(ctx, id)
+}
+
+func toDomainAccount(a models.Account) *domain.Account {
+	return &domain.Account{
+		ID:           a.ID,
+		Email:        a.Email,
+		PasswordHash: a.PasswordHash,
+		Role:         domain.Role(a.Role),
+		CreatedAt:    a.CreatedAt,
+	}
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+func setupAccountRepo(t *testing.T) (*gorm.DB, Account) {
+	db, _ := setupTestDBWithPositions(t)
+	if err := db.AutoMigrate(&models.Account{}, &models.RevokedToken{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db, NewAccount(&database.Database{DB: db}, slog.Default())
+}
+
+func TestAccountRepository_CreateAndGetAccount(t *testing.T) {
+	_, repo := setupAccountRepo(t)
+	ctx := context.Background()
+
+	created, err := repo.CreateAccount(ctx, domain.Account{Email: "doutor@fut.app", PasswordHash: "hash", Role: domain.RolePlayer})
+	if err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+	if created.ID == 0 || created.Role != domain.RolePlayer {
+		t.Fatalf("CreateAccount() = %+v, want id and role set", created)
+	}
+
+	byEmail, err := repo.GetAccountByEmail(ctx, "doutor@fut.app")
+	if err != nil {
+		t.Fatalf("GetAccountByEmail() error = %v", err)
+	}
+	if byEmail.ID != created.ID || byEmail.PasswordHash != "hash" {
+		t.Errorf("GetAccountByEmail() = %+v, want %+v", byEmail, created)
+	}
+
+	byID, err := repo.GetAccountByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetAccountByID() error = %v", err)
+	}
+	if byID.Email != created.Email {
+		t.Errorf("GetAccountByID() email = %q, want %q", byID.Email, created.Email)
+	}
+}
+
+func TestAccountRepository_CreateAccount_DuplicateEmail(t *testing.T) {
+	_, repo := setupAccountRepo(t)
+	ctx := context.Background()
+
+	if _, err := repo.CreateAccount(ctx, domain.Account{Email: "zico@fut.app", PasswordHash: "hash"}); err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+	_, err := repo.CreateAccount(ctx, domain.Account{Email: "zico@fut.app", PasswordHash: "hash"})
+	if !errors.Is(err, appErr.ErrAlreadyExists) {
+		t.Fatalf("CreateAccount() error = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestAccountRepository_RevokeToken(t *testing.T) {
+	_, repo := setupAccountRepo(t)
+	ctx := context.Background()
+
+	if err := repo.RevokeToken(ctx, "old", time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("RevokeToken() error = %v", err)
+	}
+	if err := repo.RevokeToken(ctx, "jti-1", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("RevokeToken() error = %v", err)
+	}
+
+	if revoked, err := repo.IsTokenRevoked(ctx, "jti-1"); err != nil || !revoked {
+		t.Errorf("IsTokenRevoked(jti-1) = %v, %v, want true", revoked, err)
+	}
+	// A revogação já expirada é apagada na gravação seguinte.
+	if revoked, err := repo.IsTokenRevoked(ctx, "old"); err != nil || revoked {
+		t.Errorf("IsTokenRevoked(old) = %v, %v, want false", revoked, err)
+	}
+	if err := repo.RevokeToken(ctx, "jti-1", time.Now().Add(time.Hour)); !errors.Is(err, appErr.ErrAlreadyExists) {
+		t.Errorf("RevokeToken() twice error = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestAccountRepository_SetAccountRole(t *testing.T) {
+	_, repo := setupAccountRepo(t)
+	ctx := context.Background()
+
+	created, err := repo.CreateAccount(ctx, domain.Account{Email: "rei@fut.app", PasswordHash: "hash", Role: domain.RolePlayer})
+	if err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+
+	updated, err := repo.SetAccountRole(ctx, created.ID, domain.RoleOrganiser)
+	if err != nil {
+		t.Fatalf("SetAccountRole() error = %v", err)
+	}
+	if updated.Role != domain.RoleOrganiser {
+		t.Errorf("SetAccountRole() role = %v, want organiser", updated.Role)
+	}
+
+	if _, err := repo.SetAccountRole(ctx, created.ID+1, domain.RoleAdmin); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("SetAccountRole() unknown account error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,205 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type (
+	attendanceRepository struct {
+		db     *database.Database
+		logger *slog.Logger
+	}
+	Attendance interface {
+		GetRoster(ctx context.Context, matchID uint) (*domain.Roster, error)
+		RespondRSVP(ctx context.Context, rsvp domain.RSVP, now time.Time) (*domain.Roster, error)
+		SetNoShows(ctx context.Context, matchID uint, playerIDs []uint) (*domain.Roster, error)
+		GetReliability(ctx context.Context, playerID uint) (*domain.Reliability, error)
+	}
+)
+
+// NewAttendance recebe o Database para repetir a resposta quando confirmações simultâneas
+// disputam a última vaga.
+func NewAttendance(DB *database.Database, l *slog.Logger) Attendance {
+	return &attendanceRepository{
+		db:     DB,
+		logger: l,
+	}
+}
+
+func (a *attendanceRepository) GetRoster(ctx context.Context, matchID uint) (*domain.Roster, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roster, err := loadRoster(a.db.WithContext(ctx), groupID, matchID)
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to fetch roster", err, translated, slog.Uint64("match_id", uint64(matchID)))
+		return nil, translated
+	}
+	return roster, nil
+}
+
+// RespondRSVP trava a linha da partida antes de ler a lista, para que duas confirmações ao
+// mesmo tempo não ocupem a mesma vaga.
+func (a *attendanceRepository) RespondRSVP(ctx context.Context, rsvp domain.RSVP, now time.Time) (*domain.Roster, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var roster *domain.Roster
+	err = a.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := forUpdate(tx).Select("id").Where("id = ? AND group_id = ?", rsvp.MatchID, groupID).First(&models.Match{}).Error; err != nil {
+			return err
+		}
+
+		var players int64
+		if err := tx.Model(&models.Player{}).Where("group_id = ? AND id = ?", groupID, rsvp.PlayerID).Count(&players).Error; err != nil {
+			return err
+		}
+		if players == 0 {
+			return &appErr.ValidationErrors{{Field: "player_id", Message: fmt.Sprintf("Player %d not found", rsvp.PlayerID)}}
+		}
+
+		var err error
+		if roster, err = loadRoster(tx, groupID, rsvp.MatchID); err != nil {
+			return err
+		}
+		return saveAttendances(tx, roster.Respond(rsvp.PlayerID, rsvp.Going, now))
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to respond rsvp", err, translated,
+			slog.Uint64("match_id", uint64(rsvp.MatchID)),
+			slog.Uint64("player_id", uint64(rsvp.PlayerID)),
+		)
+		return nil, translated
+	}
+	return roster, nil
+}
+
+func (a *attendanceRepository) SetNoShows(ctx context.Context, matchID uint, playerIDs []uint) (*domain.Roster, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var roster *domain.Roster
+	err = a.db.Transaction(ctx, func(tx *gorm.DB) error {
+		var err error
+		if roster, err = loadRoster(tx, groupID, matchID); err != nil {
+			return err
+		}
+		changed, err := roster.MarkNoShows(playerIDs)
+		if err != nil {
+			return err
+		}
+		return saveAttendances(tx, changed)
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to record no-shows", err, translated, slog.Uint64("match_id", uint64(matchID)))
+		return nil, translated
+	}
+	return roster, nil
+}
+
+// GetReliability conta só as partidas encerradas: antes do fim, ninguém faltou ainda.
+func (a *attendanceRepository) GetReliability(ctx context.Context, playerID uint) (*domain.Reliability, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts struct {
+		Confirmed int
+		NoShows   int
+	}
+	err = a.db.WithContext(ctx).Model(&models.MatchAttendance{}).
+		Select("COUNT(*) AS confirmed, COALESCE(SUM(CASE WHEN match_attendances.no_show THEN 1 ELSE 0 END), 0) AS no_shows").
+		Joins("JOIN matches ON matches.id = match_attendances.match_id AND matches.deleted_at IS NULL").
+		Where("matches.group_id = ? AND matches.status = ?", groupID, string(domain.MatchFinished)).
+		Where("match_attendances.player_id = ? AND match_attendances.status = ?", playerID, string(domain.AttendanceGoing)).
+		Scan(&counts).Error
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, a.logger), "error when trying to fetch reliability", err, translated, slog.Uint64("player_id", uint64(playerID)))
+		return nil, translated
+	}
+	reliability := domain.NewReliability(counts.Confirmed, counts.NoShows)
+	return &reliability, nil
+}
+
+// loadRoster trata a partida de outro grupo como inexistente.
+func loadRoster(db *gorm.DB, groupID, matchID uint) (*domain.Roster, error) {
+	var match models.Match
+	if err := db.Select("id", "max_players").Where("group_id = ?", groupID).First(&match, matchID).Error; err != nil {
+		return nil, err
+	}
+
+	var attendances []models.MatchAttendance
+	if err := db.Where("match_id = ?", matchID).Order("responded_at, id").Find(&attendances).Error; err != nil {
+		return nil, err
+	}
+
+	roster := &domain.Roster{MatchID: match.ID, MaxPlayers: match.MaxPlayers, Attendances: make([]domain.Attendance, len(attendances))}
+	for i, ma := range attendances {
+		roster.Attendances[i] = toDomainAttendance(ma)
+	}
+	return roster, nil
+}
+
+// saveAttendances grava as presenças alteradas, criando a do jogador que responde pela
+// primeira vez.
+func saveAttendances(tx *gorm.DB, attendances []domain.Attendance) error {
+	if len(attendances) == 0 {
+		return nil
+	}
+	rows := make([]models.MatchAttendance, len(attendances))
+	for i, att := range attendances {
+		rows[i] = models.MatchAttendance{
+			MatchID:     att.MatchID,
+			PlayerID:    att.PlayerID,
+			Status:      string(att.Status),
+			RespondedAt: att.RespondedAt,
+			NoShow:      att.NoShow,
+		}
+	}
+	return tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "match_id"}, {Name: "player_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "status", "responded_at", "no_show"}),
+	}).Create(&rows).Error
+}
+
+// promoteWaitlist preenche com a fila de espera as vagas abertas depois de mudar o limite
+// de confirmados.
+func promoteWaitlist(tx *gorm.DB, groupID, matchID uint) error {
+	roster, err := loadRoster(tx, groupID, matchID)
+	if err != nil {
+		return err
+	}
+	return saveAttendances(tx, roster.Promote())
+}
+
+func toDomainAttendance(ma models.MatchAttendance) domain.Attendance {
+	return domain.Attendance{
+		MatchID:     ma.MatchID,
+		PlayerID:    ma.PlayerID,
+		Status:      domain.AttendanceStatus(ma.Status),
+		RespondedAt: ma.RespondedAt,
+		NoShow:      ma.NoShow,
+	}
+}
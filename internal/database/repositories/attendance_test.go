@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"fut-app/internal/database"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+)
+
+var rsvpAt = time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC)
+
+// setupAttendanceTest cria uma partida sem escalação com limite de dois confirmados.
+func setupAttendanceTest(t *testing.T) (Attendance, Match, *domain.Match, []uint) {
+	t.Helper()
+	db, ids := setupMatchTestDB(t)
+	matches := NewMatch(&database.Database{DB: db}, slog.Default())
+	match := newTestMatch(nil, nil)
+	match.MaxPlayers = 2
+	created, err := matches.CreateMatch(groupCtx(), match)
+	if err != nil {
+		t.Fatalf("CreateMatch() error = %v", err)
+	}
+	return NewAttendance(&database.Database{DB: db}, slog.Default()), matches, created, ids
+}
+
+func respond(t *testing.T, repo Attendance, matchID, playerID uint, going bool, at time.Time) *domain.Roster {
+	t.Helper()
+	roster, err := repo.RespondRSVP(groupCtx(), domain.RSVP{MatchID: matchID, PlayerID: playerID, Going: going}, at)
+	if err != nil {
+		t.Fatalf("RespondRSVP(%d) error = %v", playerID, err)
+	}
+	return roster
+}
+
+func TestAttendanceRepository_RespondRSVP_WaitlistAndPromotion(t *testing.T) {
+	repo, _, match, ids := setupAttendanceTest(t)
+	for i, id := range ids[:3] {
+		respond(t, repo, match.ID, id, true, rsvpAt.Add(time.Duration(i)*time.Minute))
+	}
+
+	roster, err := repo.GetRoster(groupCtx(), match.ID)
+	if err != nil {
+		t.Fatalf("GetRoster() error = %v", err)
+	}
+	if going := roster.Going(); len(going) != 2 || roster.Attendances[2].Status != domain.AttendanceWaitlisted {
+		t.Fatalf("GetRoster() = %+v, want third player waitlisted", roster.Attendances)
+	}
+
+	roster = respond(t, repo, match.ID, ids[0], false, rsvpAt.Add(time.Hour))
+	if going := roster.Going(); len(going) != 2 || going[0] != ids[1] || going[1] != ids[2] {
+		t.Errorf("RespondRSVP() going = %v, want %v promoted", going, ids[2])
+	}
+}
+
+func TestAttendanceRepository_RespondRSVP_Errors(t *testing.T) {
+	repo, _, match, _ := setupAttendanceTest(t)
+
+	var ve *appErr.ValidationErrors
+	if _, err := repo.RespondRSVP(groupCtx(), domain.RSVP{MatchID: match.ID, PlayerID: 999, Going: true}, rsvpAt); !errors.As(err, &ve) {
+		t.Errorf("RespondRSVP() unknown player error = %v, want *ValidationErrors", err)
+	}
+
+	otherGroup := domain.WithMembership(context.Background(), domain.Membership{GroupID: 2})
+	if _, err := repo.RespondRSVP(otherGroup, domain.RSVP{MatchID: match.ID, PlayerID: 1, Going: true}, rsvpAt); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("RespondRSVP() other group error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestAttendanceRepository_UpdateMatchPromotesWaitlist(t *testing.T) {
+	repo, matches, match, ids := setupAttendanceTest(t)
+	for i, id := range ids[:3] {
+		respond(t, repo, match.ID, id, true, rsvpAt.Add(time.Duration(i)*time.Minute))
+	}
+
+	match.MaxPlayers = 3
+	if _, err := matches.UpdateMatch(groupCtx(), *match, domain.MatchScheduled); err != nil {
+		t.Fatalf("UpdateMatch() error = %v", err)
+	}
+	roster, err := repo.GetRoster(groupCtx(), match.ID)
+	if err != nil || len(roster.Going()) != 3 {
+		t.Errorf("GetRoster() after raising the limit = %+v, %v, want 3 going", roster, err)
+	}
+}
+
+func TestAttendanceRepository_NoShowsAndReliability(t *testing.T) {
+	repo, matches, match, ids := setupAttendanceTest(t)
+	respond(t, repo, match.ID, ids[0], true, rsvpAt)
+	respond(t, repo, match.ID, ids[1], true, rsvpAt.Add(time.Minute))
+
+	if _, err := repo.SetNoShows(groupCtx(), match.ID, []uint{ids[1]}); err != nil {
+		t.Fatalf("SetNoShows() error = %v", err)
+	}
+	// Enquanto a partida não termina, a confirmação não conta.
+	if got, err := repo.GetReliability(groupCtx(), ids[1]); err != nil || got.Confirmed != 0 {
+		t.Errorf("GetReliability() before the end = %+v, %v, want nothing confirmed", got, err)
+	}
+
+	match.Status = domain.MatchFinished
+	if _, err := matches.UpdateMatch(groupCtx(), *match, domain.MatchScheduled); err != nil {
+		t.Fatalf("UpdateMatch() error = %v", err)
+	}
+	got, err := repo.GetReliability(groupCtx(), ids[1])
+	if err != nil {
+		t.Fatalf("GetReliability() error = %v", err)
+	}
+	if *got != domain.NewReliability(1, 1) {
+		t.Errorf("GetReliability() = %+v, want one confirmation and one no-show", *got)
+	}
+	if got, _ := repo.GetReliability(groupCtx(), ids[0]); got.Rate != 100 || got.Confirmed != 1 {
+		t.Errorf("GetReliability() present player = %+v, want rate 100", got)
+	}
+
+	var ve *appErr.ValidationErrors
+	if _, err := repo.SetNoShows(groupCtx(), match.ID, []uint{ids[2]}); !errors.As(err, &ve) {
+		t.Errorf("SetNoShows() unconfirmed player error = %v, want *ValidationErrors", err)
+	}
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"fut-app/internal/database"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/logger"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type violationKind int
+
+const (
+	noViolation violationKind = iota
+	checkViolation
+	uniqueViolation
+	foreignKeyViolation
+)
+
+// checkErrors associa cada CHECK do schema ao campo e à mensagem devolvidos ao cliente.
+var checkErrors = func() map[string]appErr.ValidationError {
+	checks := map[string]appErr.ValidationError{
+		"chk_positions_line":      {Field: "line", Message: fmt.Sprintf("Line must be one of %v", domain.Lines)},
+		"chk_matches_home_score":  {Field: "home_team.score", Message: "Score cannot be negative"},
+		"chk_matches_away_score":  {Field: "away_team.score", Message: "Score cannot be negative"},
+		"chk_matches_max_players": {Field: "max_players", Message: "Max players cannot be negative"},
+	}
+	for _, stat := range domain.StatNames {
+		checks["chk_ratings_"+stat] = appErr.ValidationError{
+			Field:   stat,
+			Message: fmt.Sprintf("%s must be between %d and %d", stat, domain.MinAttribute, domain.MaxAttribute),
+		}
+	}
+	return checks
+}()
+
+// translateError converte o erro do banco no erro do domínio: UNIQUE vira ErrAlreadyExists,
+// CHECK vira ValidationErrors no campo da constraint, FOREIGN KEY vira ErrNotFound e falhas
+// de conexão viram ErrDatabase. Os sentinels são embrulhados junto com a causa, então
+// errors.Is funciona para os dois; erros não reconhecidos voltam sem alteração.
+// Cancelamento e timeout do contexto também voltam intactos: não são falha do banco.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", appErr.ErrNotFound, err)
+	}
+
+	constraint, kind := constraintViolation(err)
+	switch kind {
+	case checkViolation:
+		field, ok := checkErrors[constraint]
+		if !ok {
+			name := constraint[strings.LastIndex(constraint, "_")+1:]
+			field = appErr.ValidationError{Field: name, Message: fmt.Sprintf("%s is invalid", name)}
+		}
+		return &appErr.ValidationErrors{field}
+	case uniqueViolation:
+		return fmt.Errorf("%w: %w", appErr.ErrAlreadyExists, err)
+	case foreignKeyViolation:
+		return fmt.Errorf("%w: %w", appErr.ErrNotFound, err)
+	}
+
+	if isConnectionError(err) {
+		return fmt.Errorf("%w: %w", appErr.ErrDatabase, err)
+	}
+	return err
+}
+
+// constraintViolation identifica violações de CHECK, UNIQUE e FOREIGN KEY no Postgres (pelo
+// SQLSTATE) e no SQLite (pelo código estendido). O SQLite só informa o nome da constraint
+// na mensagem, e não o informa para foreign keys.
+func constraintViolation(err error) (string, violationKind) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23514":
+			return pgErr.ConstraintName, checkViolation
+		case "23505":
+			return pgErr.ConstraintName, uniqueViolation
+		case "23503":
+			return pgErr.ConstraintName, foreignKeyViolation
+		}
+		return "", noViolation
+	}
+	return sqliteConstraintViolation(err)
+}
+
+// isConnectionError cobre falhas para abrir ou manter a conexão e os erros transitórios
+// que sobraram depois dos retries de database.Database.
+func isConnectionError(err error) bool {
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &connectErr), errors.As(err, &netErr),
+		errors.Is(err, driver.ErrBadConn), errors.Is(err, sql.ErrConnDone),
+		strings.Contains(err.Error(), "sql: database is closed"),
+		isSQLiteIOError(err):
+		return true
+	}
+	return database.IsTransient(err)
+}
+
+// requestLogger devolve o logger da requisição guardado em ctx, que carrega o request ID,
+// ou o logger do repositório fora de uma requisição.
+func requestLogger(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	return logger.FromContextOr(ctx, fallback)
+}
+
+// logFailure registra a causa original de uma falha do repositório. Erros do cliente
+// (dado inválido, duplicado ou inexistente) vão como Warn; o resto como Error.
+func logFailure(logger *slog.Logger, msg string, cause, translated error, attrs ...any) {
+	attrs = append(attrs, slog.String("error", cause.Error()))
+	var ve *appErr.ValidationErrors
+	if errors.As(translated, &ve) || errors.Is(translated, appErr.ErrNotFound) || errors.Is(translated, appErr.ErrAlreadyExists) ||
+		errors.Is(translated, context.Canceled) || errors.Is(translated, context.DeadlineExceeded) {
+		logger.Warn(msg, attrs...)
+		return
+	}
+	logger.Error(msg, attrs...)
+}
@@ -0,0 +1,250 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type (
+	groupRepository struct {
+		db      *database.Database
+		players *playerRepository
+		logger  *slog.Logger
+	}
+	Group interface {
+		CreateGroup(ctx context.Context, group domain.Group, accountID uint, player domain.Player) (*domain.Group, error)
+		JoinGroup(ctx context.Context, code string, accountID uint, player domain.Player) (*domain.Group, error)
+		GetGroupByID(context.Context, uint) (*domain.Group, error)
+		GetGroupsByAccount(ctx context.Context, accountID uint) ([]domain.Group, error)
+		GetMembership(ctx context.Context, groupID, accountID uint) (*domain.Membership, error)
+		GetMembers(context.Context) ([]domain.GroupMember, error)
+		SetJoinCode(ctx context.Context, code string) (*domain.Group, error)
+		SetMemberRole(ctx context.Context, playerID uint, role domain.Role) (*domain.GroupMember, error)
+	}
+)
+
+// errNoGroup é uma consulta de dados do grupo feita fora de uma rota de grupo. É bug de
+// quem chama, então falha em vez de ler os dados de todos os grupos.
+var errNoGroup = errors.New("query requires the request group")
+
+// requestGroup devolve o grupo da requisição, que restringe toda consulta de jogadores,
+// partidas e notas.
+func requestGroup(ctx context.Context) (uint, error) {
+	m, ok := domain.MembershipFromContext(ctx)
+	if !ok || m.GroupID == 0 {
+		return 0, errNoGroup
+	}
+	return m.GroupID, nil
+}
+
+func NewGroup(DB *database.Database, l *slog.Logger) Group {
+	return &groupRepository{
+		db:      DB,
+		players: &playerRepository{db: DB, logger: l},
+		logger:  l,
+	}
+}
+
+// CreateGroup grava o grupo, o jogador de quem cria e o vínculo de organizador na mesma
+// transação.
+func (g *groupRepository) CreateGroup(ctx context.Context, group domain.Group, accountID uint, player domain.Player) (*domain.Group, error) {
+	var modelGroup models.Group
+	err := g.db.Transaction(ctx, func(tx *gorm.DB) error {
+		modelGroup = models.Group{Name: group.Name, JoinCode: group.JoinCode}
+		if err := tx.Create(&modelGroup).Error; err != nil {
+			return err
+		}
+		return g.addMember(tx, modelGroup.ID, accountID, player, domain.RoleOrganiser)
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, g.logger), "error when trying to create group", err, translated)
+		return nil, translated
+	}
+	return toDomainGroup(modelGroup), nil
+}
+
+// JoinGroup cria o jogador da conta no grupo do código. Entrar de novo no mesmo grupo
+// devolve ErrAlreadyExists.
+func (g *groupRepository) JoinGroup(ctx context.Context, code string, accountID uint, player domain.Player) (*domain.Group, error) {
+	var modelGroup models.Group
+	err := g.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := tx.Where("join_code = ?", code).First(&modelGroup).Error; err != nil {
+			return err
+		}
+		return g.addMember(tx, modelGroup.ID, accountID, player, domain.RolePlayer)
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, g.logger), "error when trying to join group", err, translated)
+		return nil, translated
+	}
+	return toDomainGroup(modelGroup), nil
+}
+
+func (g *groupRepository) GetGroupByID(ctx context.Context, id uint) (*domain.Group, error) {
+	var modelGroup models.Group
+	if err := g.db.WithContext(ctx).First(&modelGroup, id).Error; err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, g.logger), "error when trying to fetch group", err, translated, slog.Uint64("id", uint64(id)))
+		return nil, translated
+	}
+	return toDomainGroup(modelGroup), nil
+}
+
+func (g *groupRepository) GetGroupsByAccount(ctx context.Context, accountID uint) ([]domain.Group, error) {
+	var modelGroups []models.Group
+	err := g.db.WithContext(ctx).
+		Joins("JOIN group_members ON group_members.group_id = groups.id AND group_members.account_id = ?", accountID).
+		Joins("JOIN players ON players.id = group_members.player_id AND players.deleted_at IS NULL").
+		Order("groups.name, groups.id").
+		Find(&modelGroups).Error
+	if err != nil {
+		requestLogger(ctx, g.logger).Error("error when trying to list groups", slog.String("error", err.Error()))
+		return nil, translateError(err)
+	}
+
+	groups := make([]domain.Group, len(modelGroups))
+	for i, mg := range modelGroups {
+		groups[i] = *toDomainGroup(mg)
+	}
+	return groups, nil
+}
+
+// GetMembership ignora vínculos cujo jogador foi removido: remover o jogador tira a conta
+// do grupo.
+func (g *groupRepository) GetMembership(ctx context.Context, groupID, accountID uint) (*domain.Membership, error) {
+	var member models.GroupMember
+	err := g.members(g.db.WithContext(ctx), groupID).
+		Where("group_members.account_id = ?", accountID).
+		First(&member).Error
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, g.logger), "error when trying to fetch membership", err, translated,
+			slog.Uint64("group_id", uint64(groupID)),
+			slog.Uint64("account_id", uint64(accountID)),
+		)
+		return nil, translated
+	}
+	membership := toDomainMembership(member)
+	return &membership, nil
+}
+
+func (g *groupRepository) GetMembers(ctx context.Context) ([]domain.GroupMember, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []models.GroupMember
+	err = g.members(g.db.WithContext(ctx), groupID).
+		Preload("Player").
+		Order("players.name, group_members.id").
+		Find(&members).Error
+	if err != nil {
+		requestLogger(ctx, g.logger).Error("error when trying to list members", slog.String("error", err.Error()))
+		return nil, translateError(err)
+	}
+
+	result := make([]domain.GroupMember, len(members))
+	for i, m := range members {
+		result[i] = toDomainGroupMember(m)
+	}
+	return result, nil
+}
+
+func (g *groupRepository) SetJoinCode(ctx context.Context, code string) (*domain.Group, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.db.WithContext(ctx).Model(&models.Group{}).
+		Where("id = ?", groupID).
+		Update("join_code", code).Error
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, g.logger), "error when trying to update join code", err, translated, slog.Uint64("id", uint64(groupID)))
+		return nil, translated
+	}
+	return g.GetGroupByID(ctx, groupID)
+}
+
+func (g *groupRepository) SetMemberRole(ctx context.Context, playerID uint, role domain.Role) (*domain.GroupMember, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var member models.GroupMember
+	err = g.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := g.members(tx, groupID).Where("group_members.player_id = ?", playerID).First(&member).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&member).Update("role", string(role)).Error; err != nil {
+			return err
+		}
+		return tx.Preload("Player").First(&member, member.ID).Error
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, g.logger), "error when trying to update member role", err, translated, slog.Uint64("player_id", uint64(playerID)))
+		return nil, translated
+	}
+	result := toDomainGroupMember(member)
+	return &result, nil
+}
+
+// members filtra os vínculos do grupo com jogador ainda ativo.
+func (g *groupRepository) members(db *gorm.DB, groupID uint) *gorm.DB {
+	return db.Model(&models.GroupMember{}).
+		Joins("JOIN players ON players.id = group_members.player_id AND players.deleted_at IS NULL").
+		Where("group_members.group_id = ?", groupID)
+}
+
+func (g *groupRepository) addMember(tx *gorm.DB, groupID, accountID uint, player domain.Player, role domain.Role) error {
+	created, err := g.players.createPlayer(tx, groupID, player)
+	if err != nil {
+		return err
+	}
+	member := models.GroupMember{
+		GroupID:   groupID,
+		AccountID: accountID,
+		PlayerID:  created.ID,
+		Role:      string(role),
+	}
+	return tx.Omit(clause.Associations).Create(&member).Error
+}
+
+func toDomainGroup(g models.Group) *domain.Group {
+	return &domain.Group{
+		ID:        g.ID,
+		Name:      g.Name,
+		JoinCode:  g.JoinCode,
+		CreatedAt: g.CreatedAt,
+	}
+}
+
+func toDomainMembership(m models.GroupMember) domain.Membership {
+	return domain.Membership{
+		GroupID:   m.GroupID,
+		AccountID: m.AccountID,
+		PlayerID:  m.PlayerID,
+		Role:      domain.Role(m.Role),
+	}
+}
+
+func toDomainGroupMember(m models.GroupMember) domain.GroupMember {
+	return domain.GroupMember{
+		Membership: toDomainMembership(m),
+		Name:       m.Player.Name,
+	}
+}
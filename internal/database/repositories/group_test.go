@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+func setupGroupRepo(t *testing.T) (*gorm.DB, Group, Account) {
+	db, _ := setupTestDBWithPositions(t)
+	if err := db.AutoMigrate(&models.Account{}, &models.Group{}, &models.GroupMember{}, &models.Match{}, &models.MatchPlayer{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	dbase := &database.Database{DB: db}
+	return db, NewGroup(dbase, slog.Default()), NewAccount(dbase, slog.Default())
+}
+
+func newTestAccount(t *testing.T, accounts Account, email string) uint {
+	t.Helper()
+	account, err := accounts.CreateAccount(context.Background(), domain.Account{Email: email, PasswordHash: "hash", Role: domain.RolePlayer})
+	if err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+	return account.ID
+}
+
+func newTestGroupPlayer(name string) domain.Player {
+	return domain.Player{Name: name, Stats: newTestStats(), Position: []string{"Meio-campo"}}
+}
+
+// memberCtx é a requisição da conta dentro do grupo, como o GroupScope monta.
+func memberCtx(t *testing.T, repo Group, groupID, accountID uint) context.Context {
+	t.Helper()
+	m, err := repo.GetMembership(context.Background(), groupID, accountID)
+	if err != nil {
+		t.Fatalf("GetMembership() error = %v", err)
+	}
+	return domain.WithMembership(context.Background(), *m)
+}
+
+func TestGroupRepository_CreateAndJoinGroup(t *testing.T) {
+	_, repo, accounts := setupGroupRepo(t)
+	ctx := context.Background()
+	zico := newTestAccount(t, accounts, "zico@fut.app")
+	socrates := newTestAccount(t, accounts, "doutor@fut.app")
+
+	group, err := repo.CreateGroup(ctx, domain.Group{Name: "Quarta", JoinCode: "ABCD2345"}, zico, newTestGroupPlayer("Zico"))
+	if err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if _, err := repo.JoinGroup(ctx, "ABCD2345", socrates, newTestGroupPlayer("Sócrates")); err != nil {
+		t.Fatalf("JoinGroup() error = %v", err)
+	}
+
+	owner, err := repo.GetMembership(ctx, group.ID, zico)
+	if err != nil || owner.Role != domain.RoleOrganiser || owner.PlayerID == 0 {
+		t.Fatalf("GetMembership() creator = %+v, %v, want organiser with player", owner, err)
+	}
+	members, err := repo.GetMembers(domain.WithMembership(ctx, *owner))
+	if err != nil {
+		t.Fatalf("GetMembers() error = %v", err)
+	}
+	if len(members) != 2 || members[0].Name != "Sócrates" || members[0].Role != domain.RolePlayer {
+		t.Errorf("GetMembers() = %+v", members)
+	}
+
+	if _, err := repo.JoinGroup(ctx, "ABCD2345", socrates, newTestGroupPlayer("Sócrates de novo")); !errors.Is(err, appErr.ErrAlreadyExists) {
+		t.Errorf("JoinGroup() twice error = %v, want ErrAlreadyExists", err)
+	}
+	if _, err := repo.JoinGroup(ctx, "ZZZZ9999", socrates, newTestGroupPlayer("Sócrates")); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("JoinGroup() unknown code error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGroupRepository_GroupsAreIsolated(t *testing.T) {
+	db, repo, accounts := setupGroupRepo(t)
+	ctx := context.Background()
+	zico := newTestAccount(t, accounts, "zico@fut.app")
+	falcao := newTestAccount(t, accounts, "falcao@fut.app")
+
+	quarta, err := repo.CreateGroup(ctx, domain.Group{Name: "Quarta", JoinCode: "QUARTA22"}, zico, newTestGroupPlayer("Zico"))
+	if err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	sabado, err := repo.CreateGroup(ctx, domain.Group{Name: "Sábado", JoinCode: "SABADO22"}, falcao, newTestGroupPlayer("Falcão"))
+	if err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	// Zico também joga no sábado, com outro jogador e outras notas.
+	if _, err := repo.JoinGroup(ctx, "SABADO22", zico, newTestGroupPlayer("Zico")); err != nil {
+		t.Fatalf("JoinGroup() error = %v", err)
+	}
+
+	groups, err := repo.GetGroupsByAccount(ctx, zico)
+	if err != nil || len(groups) != 2 {
+		t.Fatalf("GetGroupsByAccount() = %+v, %v, want both groups", groups, err)
+	}
+
+	quartaCtx := memberCtx(t, repo, quarta.ID, zico)
+	sabadoCtx := memberCtx(t, repo, sabado.ID, zico)
+	players := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	quartaPlayers, err := players.ListPlayers(quartaCtx, domain.PageRequest{})
+	if err != nil || len(quartaPlayers.Items) != 1 {
+		t.Fatalf("ListPlayers() quarta = %+v, %v, want only Zico", quartaPlayers, err)
+	}
+	falcaoMembership, _ := repo.GetMembership(ctx, sabado.ID, falcao)
+	if _, err := players.GetPlayerByID(quartaCtx, falcaoMembership.PlayerID); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("GetPlayerByID() from another group error = %v, want ErrNotFound", err)
+	}
+
+	matches := NewMatch(&database.Database{DB: db}, slog.Default())
+	home, away := []uint{falcaoMembership.PlayerID}, []uint{quartaPlayers.Items[0].ID}
+	if _, err := matches.CreateMatch(sabadoCtx, newTestMatch(home, away)); err == nil {
+		t.Error("CreateMatch() with a player from another group error = nil, want ValidationErrors")
+	}
+	if _, err := matches.GetMatches(context.Background()); !errors.Is(err, errNoGroup) {
+		t.Errorf("GetMatches() without group error = %v, want errNoGroup", err)
+	}
+}
+
+func TestGroupRepository_SetMemberRoleAndJoinCode(t *testing.T) {
+	db, repo, accounts := setupGroupRepo(t)
+	ctx := context.Background()
+	zico := newTestAccount(t, accounts, "zico@fut.app")
+	careca := newTestAccount(t, accounts, "careca@fut.app")
+
+	group, err := repo.CreateGroup(ctx, domain.Group{Name: "Quarta", JoinCode: "ABCD2345"}, zico, newTestGroupPlayer("Zico"))
+	if err != nil {
+		t.Fatalf("CreateGroup() error = %v", err)
+	}
+	if _, err := repo.JoinGroup(ctx, "ABCD2345", careca, newTestGroupPlayer("Careca")); err != nil {
+		t.Fatalf("JoinGroup() error = %v", err)
+	}
+	groupCtx := memberCtx(t, repo, group.ID, zico)
+	member, _ := repo.GetMembership(ctx, group.ID, careca)
+
+	updated, err := repo.SetMemberRole(groupCtx, member.PlayerID, domain.RoleOrganiser)
+	if err != nil {
+		t.Fatalf("SetMemberRole() error = %v", err)
+	}
+	if updated.Role != domain.RoleOrganiser || updated.Name != "Careca" {
+		t.Errorf("SetMemberRole() = %+v", updated)
+	}
+	if _, err := repo.SetMemberRole(groupCtx, 404, domain.RoleOrganiser); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("SetMemberRole() unknown player error = %v, want ErrNotFound", err)
+	}
+
+	rotated, err := repo.SetJoinCode(groupCtx, "WXYZ6789")
+	if err != nil || rotated.JoinCode != "WXYZ6789" {
+		t.Fatalf("SetJoinCode() = %+v, %v", rotated, err)
+	}
+
+	// Remover o jogador tira a conta do grupo.
+	if err := db.Delete(&models.Player{}, member.PlayerID).Error; err != nil {
+		t.Fatalf("delete player: %v", err)
+	}
+	if _, err := repo.GetMembership(ctx, group.ID, careca); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("GetMembership() after player removal error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,261 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type (
+	matchRepository struct {
+		db     *database.Database
+		logger *slog.Logger
+	}
+	Match interface {
+		CreateMatch(context.Context, domain.Match) (*domain.Match, error)
+		GetMatches(context.Context) ([]domain.Match, error)
+		GetMatchByID(context.Context, uint) (*domain.Match, error)
+		UpdateMatch(ctx context.Context, match domain.Match, from domain.MatchStatus) (*domain.Match, error)
+	}
+)
+
+func NewMatch(DB *database.Database, l *slog.Logger) Match {
+	return &matchRepository{
+		db:     DB,
+		logger: l,
+	}
+}
+
+func (m *matchRepository) CreateMatch(ctx context.Context, match domain.Match) (*domain.Match, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *models.Match
+	err = m.db.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := m.checkPlayersExist(tx, groupID, match); err != nil {
+			return err
+		}
+
+		modelMatch := toModelMatch(match)
+		modelMatch.GroupID = groupID
+		if err := tx.Create(&modelMatch).Error; err != nil {
+			return err
+		}
+
+		var err error
+		created, err = m.findMatch(tx, groupID, modelMatch.ID)
+		return err
+	})
+	if err != nil {
+		m.logError(ctx, "error when trying to create match", 0, err)
+		return nil, translateError(err)
+	}
+	return toDomainMatch(*created), nil
+}
+
+func (m *matchRepository) GetMatches(ctx context.Context) ([]domain.Match, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelMatches []models.Match
+	err = m.db.WithContext(ctx).Preload("Players", orderByPlayerID).
+		Where("group_id = ?", groupID).
+		Order("date DESC").
+		Find(&modelMatches).Error
+	if err != nil {
+		m.logError(ctx, "error when trying to list matches", 0, err)
+		return nil, translateError(err)
+	}
+
+	matches := make([]domain.Match, len(modelMatches))
+	for i, mm := range modelMatches {
+		matches[i] = *toDomainMatch(mm)
+	}
+	return matches, nil
+}
+
+func (m *matchRepository) GetMatchByID(ctx context.Context, id uint) (*domain.Match, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	modelMatch, err := m.findMatch(m.db.WithContext(ctx), groupID, id)
+	if err != nil {
+		m.logError(ctx, "error when trying to fetch match", id, err)
+		return nil, translateError(err)
+	}
+	return toDomainMatch(*modelMatch), nil
+}
+
+// UpdateMatch grava a partida se o status ainda for from, o lido antes do patch. Com a
+// linha travada, duas atualizações simultâneas não efetivam a mesma transição.
+func (m *matchRepository) UpdateMatch(ctx context.Context, match domain.Match, from domain.MatchStatus) (*domain.Match, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *models.Match
+	err = m.db.Transaction(ctx, func(tx *gorm.DB) error {
+		current, err := m.findMatch(forUpdate(tx), groupID, match.ID)
+		if err != nil {
+			return err
+		}
+		if domain.MatchStatus(current.Status) != from {
+			return &appErr.ValidationErrors{{Field: "status", Message: fmt.Sprintf("The match status changed to '%s' meanwhile; reload it and try again", current.Status)}}
+		}
+		if err := m.checkPlayersExist(tx, groupID, match); err != nil {
+			return err
+		}
+
+		// Save grava todas as colunas: o Model da linha carregada preserva o created_at.
+		modelMatch := toModelMatch(match)
+		modelMatch.Model = current.Model
+		modelMatch.GroupID = groupID
+		if err := tx.Omit("Players").Save(&modelMatch).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("match_id = ?", match.ID).Delete(&models.MatchPlayer{}).Error; err != nil {
+			return err
+		}
+		if len(modelMatch.Players) > 0 {
+			if err := tx.Create(&modelMatch.Players).Error; err != nil {
+				return err
+			}
+		}
+		// Aumentar ou tirar o limite de confirmados abre vaga para a fila de espera.
+		if match.Status == domain.MatchScheduled {
+			if err := promoteWaitlist(tx, groupID, match.ID); err != nil {
+				return err
+			}
+		}
+
+		updated, err = m.findMatch(tx, groupID, match.ID)
+		return err
+	})
+	if err != nil {
+		m.logError(ctx, "error when trying to update match", match.ID, err)
+		return nil, translateError(err)
+	}
+	return toDomainMatch(*updated), nil
+}
+
+// findMatch trata a partida de outro grupo como inexistente.
+func (m *matchRepository) findMatch(db *gorm.DB, groupID, id uint) (*models.Match, error) {
+	var modelMatch models.Match
+	if err := db.Preload("Players", orderByPlayerID).Where("group_id = ?", groupID).First(&modelMatch, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErr.ErrNotFound
+		}
+		return nil, err
+	}
+	return &modelMatch, nil
+}
+
+// checkPlayersExist garante que todos os jogadores escalados existem no grupo, reportando
+// cada ausente.
+func (m *matchRepository) checkPlayersExist(db *gorm.DB, groupID uint, match domain.Match) error {
+	ids := append(append([]uint{}, match.HomeTeam.PlayerIDs...), match.AwayTeam.PlayerIDs...)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var found []uint
+	if err := db.Model(&models.Player{}).Where("group_id = ? AND id IN ?", groupID, ids).Pluck("id", &found).Error; err != nil {
+		return err
+	}
+	existing := make(map[uint]bool, len(found))
+	for _, id := range found {
+		existing[id] = true
+	}
+
+	var errs appErr.ValidationErrors
+	for _, id := range ids {
+		if !existing[id] {
+			errs.Append("players", fmt.Sprintf("Player %d not found", id))
+		}
+	}
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+func (m *matchRepository) logError(ctx context.Context, msg string, id uint, err error) {
+	var ve *appErr.ValidationErrors
+	if errors.Is(err, appErr.ErrNotFound) || errors.As(err, &ve) {
+		return
+	}
+	logFailure(requestLogger(ctx, m.logger), msg, err, translateError(err), slog.Uint64("id", uint64(id)))
+}
+
+// forUpdate trava as linhas lidas até o fim da transação. O SQLite dispensa a trava: ele já
+// usa uma única conexão.
+func forUpdate(tx *gorm.DB) *gorm.DB {
+	if tx.Dialector.Name() == database.DriverPostgres {
+		return tx.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	return tx
+}
+
+func orderByPlayerID(db *gorm.DB) *gorm.DB {
+	return db.Order("player_id")
+}
+
+func toModelMatch(match domain.Match) models.Match {
+	modelMatch := models.Match{
+		Date:         match.KickoffAt,
+		Venue:        match.Venue,
+		Status:       string(match.Status),
+		HomeTeamName: match.HomeTeam.Name,
+		AwayTeamName: match.AwayTeam.Name,
+		HomeScore:    match.HomeTeam.Score,
+		AwayScore:    match.AwayTeam.Score,
+		MaxPlayers:   match.MaxPlayers,
+		RSVPDeadline: match.RSVPDeadline,
+	}
+	modelMatch.ID = match.ID
+	for _, id := range match.HomeTeam.PlayerIDs {
+		modelMatch.Players = append(modelMatch.Players, models.MatchPlayer{MatchID: match.ID, PlayerID: id, Team: models.HomeTeam})
+	}
+	for _, id := range match.AwayTeam.PlayerIDs {
+		modelMatch.Players = append(modelMatch.Players, models.MatchPlayer{MatchID: match.ID, PlayerID: id, Team: models.AwayTeam})
+	}
+	return modelMatch
+}
+
+func toDomainMatch(m models.Match) *domain.Match {
+	match := &domain.Match{
+		ID:           m.ID,
+		Venue:        m.Venue,
+		KickoffAt:    m.Date,
+		Status:       domain.MatchStatus(m.Status),
+		HomeTeam:     domain.Team{Name: m.HomeTeamName, PlayerIDs: []uint{}, Score: m.HomeScore},
+		AwayTeam:     domain.Team{Name: m.AwayTeamName, PlayerIDs: []uint{}, Score: m.AwayScore},
+		MaxPlayers:   m.MaxPlayers,
+		RSVPDeadline: m.RSVPDeadline,
+	}
+	for _, mp := range m.Players {
+		switch mp.Team {
+		case models.HomeTeam:
+			match.HomeTeam.PlayerIDs = append(match.HomeTeam.PlayerIDs, mp.PlayerID)
+		case models.AwayTeam:
+			match.AwayTeam.PlayerIDs = append(match.AwayTeam.PlayerIDs, mp.PlayerID)
+		}
+	}
+	return match
+}
@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+func setupMatchTestDB(t *testing.T) (*gorm.DB, []uint) {
+	db, _ := setupTestDBWithPositions(t)
+	if err := db.AutoMigrate(&models.Match{}, &models.MatchPlayer{}, &models.MatchAttendance{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+	var ids []uint
+	for _, name := range []string{"Zico", "Sócrates", "Falcão", "Careca"} {
+		p, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: name, Stats: newTestStats(), Position: []string{"Meio-campo"}})
+		if err != nil {
+			t.Fatalf("CreatePlayer() error = %v", err)
+		}
+		ids = append(ids, p.ID)
+	}
+	return db, ids
+}
+
+func newTestMatch(home, away []uint) domain.Match {
+	return *domain.NewMatch(
+		"Quadra da firma",
+		time.Date(2026, 10, 21, 19, 30, 0, 0, time.UTC),
+		domain.Team{Name: "Colete", PlayerIDs: home},
+		domain.Team{Name: "Sem colete", PlayerIDs: away},
+	)
+}
+
+func TestMatchRepository_CreateAndGetMatch(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	repo := NewMatch(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreateMatch(groupCtx(), newTestMatch([]uint{ids[0], ids[1]}, []uint{ids[2], ids[3]}))
+	if err != nil {
+		t.Fatalf("CreateMatch() error = %v", err)
+	}
+
+	got, err := repo.GetMatchByID(groupCtx(), created.ID)
+	if err != nil {
+		t.Fatalf("GetMatchByID() error = %v", err)
+	}
+	if got.Venue != "Quadra da firma" || got.Status != domain.MatchScheduled {
+		t.Errorf("GetMatchByID() = %+v", got)
+	}
+	if len(got.HomeTeam.PlayerIDs) != 2 || len(got.AwayTeam.PlayerIDs) != 2 {
+		t.Errorf("GetMatchByID() rosters = %v / %v", got.HomeTeam.PlayerIDs, got.AwayTeam.PlayerIDs)
+	}
+	if got.HomeTeam.Name != "Colete" || got.AwayTeam.Name != "Sem colete" {
+		t.Errorf("GetMatchByID() team names = %q / %q", got.HomeTeam.Name, got.AwayTeam.Name)
+	}
+}
+
+func TestMatchRepository_CreateMatch_UnknownPlayer(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	repo := NewMatch(&database.Database{DB: db}, slog.Default())
+
+	_, err := repo.CreateMatch(groupCtx(), newTestMatch([]uint{ids[0], 999}, []uint{ids[1]}))
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("CreateMatch() error = %v, want *ValidationErrors", err)
+	}
+	if len(*ve) != 1 || (*ve)[0].Message != "Player 999 not found" {
+		t.Errorf("CreateMatch() errors = %v", *ve)
+	}
+}
+
+func TestMatchRepository_GetMatchByID_NotFound(t *testing.T) {
+	db, _ := setupMatchTestDB(t)
+	repo := NewMatch(&database.Database{DB: db}, slog.Default())
+
+	if _, err := repo.GetMatchByID(groupCtx(), 404); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("GetMatchByID() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestMatchRepository_UpdateMatch(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	repo := NewMatch(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreateMatch(groupCtx(), newTestMatch([]uint{ids[0]}, []uint{ids[1]}))
+	if err != nil {
+		t.Fatalf("CreateMatch() error = %v", err)
+	}
+
+	home, away := 3, 2
+	created.HomeTeam.PlayerIDs = []uint{ids[0], ids[2]}
+	created.AwayTeam.PlayerIDs = []uint{ids[1], ids[3]}
+	created.HomeTeam.Score = &home
+	created.AwayTeam.Score = &away
+	created.Status = domain.MatchFinished
+
+	updated, err := repo.UpdateMatch(groupCtx(), *created, domain.MatchScheduled)
+	if err != nil {
+		t.Fatalf("UpdateMatch() error = %v", err)
+	}
+	if updated.Status != domain.MatchFinished || *updated.HomeTeam.Score != 3 || *updated.AwayTeam.Score != 2 {
+		t.Errorf("UpdateMatch() = %+v", updated)
+	}
+	if len(updated.HomeTeam.PlayerIDs) != 2 || len(updated.AwayTeam.PlayerIDs) != 2 {
+		t.Errorf("UpdateMatch() rosters = %v / %v", updated.HomeTeam.PlayerIDs, updated.AwayTeam.PlayerIDs)
+	}
+
+	var stored models.Match
+	if err := db.First(&stored, created.ID).Error; err != nil {
+		t.Fatalf("failed to load match: %v", err)
+	}
+	if stored.CreatedAt.IsZero() {
+		t.Error("UpdateMatch() reset created_at")
+	}
+
+	matches, err := repo.GetMatches(groupCtx())
+	if err != nil {
+		t.Fatalf("GetMatches() error = %v", err)
+	}
+	if len(matches) != 1 {
+		t.Errorf("GetMatches() count = %d, want 1", len(matches))
+	}
+}
+
+func TestMatchRepository_UpdateMatch_StaleStatus(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	repo := NewMatch(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreateMatch(groupCtx(), newTestMatch([]uint{ids[0]}, []uint{ids[1]}))
+	if err != nil {
+		t.Fatalf("CreateMatch() error = %v", err)
+	}
+
+	// Quem leu a partida em andamento não pode encerrá-la se o status gravado já mudou.
+	created.Status = domain.MatchFinished
+	_, err = repo.UpdateMatch(groupCtx(), *created, domain.MatchInProgress)
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) || (*ve)[0].Field != "status" {
+		t.Fatalf("UpdateMatch() error = %v, want a status ValidationErrors", err)
+	}
+	stored, err := repo.GetMatchByID(groupCtx(), created.ID)
+	if err != nil || stored.Status != domain.MatchScheduled {
+		t.Errorf("GetMatchByID() = %+v, %v, want the match still scheduled", stored, err)
+	}
+}
@@ -1,67 +1,315 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 
+	"fut-app/internal/database"
 	"fut-app/internal/database/models"
 	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
 
 	"gorm.io/gorm"
 )
 
 type (
 	playerRepository struct {
-		db     *gorm.DB
+		db     *database.Database
 		logger *slog.Logger
 	}
 	Player interface {
-		CreatePlayer(domain.Player) (*domain.Player, error)
-		// GetPlayers() []models.Player
-		// GetPlayerByID(uint) (*models.Player, error)
-		// UpdatePlayer(domain.Player) error
-		// DeletePlayer(uint) error
+		CreatePlayer(context.Context, domain.Player) (*domain.Player, error)
+		ListPlayers(context.Context, domain.PageRequest) (*domain.Page[domain.Player], error)
+		GetPlayerByID(context.Context, uint) (*domain.Player, error)
+		GetPlayersByIDs(context.Context, []uint) ([]domain.Player, error)
+		UpdatePlayer(ctx context.Context, id uint, update domain.PlayerUpdate) (*domain.Player, error)
+		DeletePlayer(context.Context, uint) error
+		UpdateStats(ctx context.Context, id uint, stats domain.Stats) error
+		EachStoredStats(ctx context.Context, fn func([]domain.StoredStats) error) error
+		UpdateStoredStats(ctx context.Context, id uint, stats domain.Stats) error
 	}
 )
 
-func NewPlayer(DB *gorm.DB, l *slog.Logger) Player {
+// playerQuery é a lista branca de GET /players: ordenação por nome, overall ou cadastro,
+// busca pelo nome e filtro pelo nome ou sigla da posição.
+var playerQuery = database.QuerySpec{
+	Sortable: map[string]string{
+		"id":         "id",
+		"name":       "name",
+		"overall":    "overall",
+		"created_at": "created_at",
+	},
+	DefaultSort: "name",
+	Searchable:  []string{"name"},
+	Filters: map[string]database.Filter{
+		"position": func(query *gorm.DB, value string) *gorm.DB {
+			playerIDs := query.Session(&gorm.Session{NewDB: true}).
+				Table("player_positions").
+				Select("player_positions.player_id").
+				Joins("JOIN positions ON positions.id = player_positions.position_id AND positions.deleted_at IS NULL").
+				Where("LOWER(positions.name) = LOWER(?) OR LOWER(positions.abbreviation) = LOWER(?)", value, value)
+			return query.Where("id IN (?)", playerIDs)
+		},
+	},
+	Preload: []string{"Position"},
+}
+
+// storedStatsBatchSize é o lote da migração de stats; cada jogador do lote ainda é
+// regravado individualmente.
+var storedStatsBatchSize = 200
+
+func NewPlayer(DB *database.Database, l *slog.Logger) Player {
 	return &playerRepository{
 		db:     DB,
 		logger: l,
 	}
 }
 
-func (p *playerRepository) CreatePlayer(player domain.Player) (*domain.Player, error) {
-	positions, err := p.getPositions(player)
+// CreatePlayer grava o jogador no grupo da requisição, com suas posições, numa única
+// transação e devolve o registro relido, para que uma falha no meio não deixe associações
+// parciais.
+func (p *playerRepository) CreatePlayer(ctx context.Context, player domain.Player) (*domain.Player, error) {
+	groupID, err := requestGroup(ctx)
 	if err != nil {
 		return nil, err
 	}
-	stats := models.JSONB(player.Stats)
-	modelPlayer := models.Player{
-		Name:     player.Name,
-		Stats:    &stats,
-		Position: positions,
+
+	var created *models.Player
+	err = p.db.Transaction(ctx, func(tx *gorm.DB) error {
+		var err error
+		created, err = p.createPlayer(tx, groupID, player)
+		return err
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to create player", err, translated, slog.String("name", player.Name))
+		return nil, translated
 	}
-	err = p.db.Create(&modelPlayer).Error
+	return toDomainPlayer(*created), nil
+}
+
+// ListPlayers devolve uma página de jogadores do grupo; ordenação e filtros fora de
+// playerQuery voltam como ValidationErrors.
+func (p *playerRepository) ListPlayers(ctx context.Context, req domain.PageRequest) (*domain.Page[domain.Player], error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := database.Paginate[models.Player](ctx, p.db.DB.Where("group_id = ?", groupID), database.QueryOptions{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Sort:     req.Sort,
+		Search:   req.Search,
+		Filters:  req.Filters,
+	}, playerQuery)
+	if err != nil {
+		var ve *appErr.ValidationErrors
+		if !errors.As(err, &ve) {
+			requestLogger(ctx, p.logger).Error("error when trying to list players", slog.String("error", err.Error()))
+		}
+		return nil, translateError(err)
+	}
+
+	players := make([]domain.Player, len(result.Data))
+	for i, mp := range result.Data {
+		players[i] = *toDomainPlayer(mp)
+	}
+	page := domain.NewPage(players, result.Total, result.Page, result.PageSize)
+	return &page, nil
+}
+
+func (p *playerRepository) GetPlayerByID(ctx context.Context, id uint) (*domain.Player, error) {
+	groupID, err := requestGroup(ctx)
 	if err != nil {
-		p.logger.Error("error when trying to create player",
-			slog.String("name", player.Name),
-			slog.String("error", err.Error()),
-		)
 		return nil, err
 	}
 
-	if err := p.db.Preload("Position").First(&modelPlayer, modelPlayer.ID).Error; err != nil {
+	modelPlayer, err := p.findPlayer(p.db.WithContext(ctx), groupID, id)
+	if err != nil {
 		return nil, err
 	}
+	return toDomainPlayer(*modelPlayer), nil
+}
+
+func (p *playerRepository) GetPlayersByIDs(ctx context.Context, ids []uint) ([]domain.Player, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelPlayers []models.Player
+	if err := p.db.WithContext(ctx).Preload("Position").Where("group_id = ? AND id IN ?", groupID, ids).Order("id").Find(&modelPlayers).Error; err != nil {
+		requestLogger(ctx, p.logger).Error("error when trying to fetch players", slog.String("error", err.Error()))
+		return nil, translateError(err)
+	}
+
+	players := make([]domain.Player, len(modelPlayers))
+	for i, mp := range modelPlayers {
+		players[i] = *toDomainPlayer(mp)
+	}
+	return players, nil
+}
+
+// UpdatePlayer aplica o update sobre o jogador gravado na mesma transação, para que um
+// update sem stats não sobrescreva os recalculados pelas notas nesse meio tempo.
+func (p *playerRepository) UpdatePlayer(ctx context.Context, id uint, update domain.PlayerUpdate) (*domain.Player, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *models.Player
+	err = p.db.Transaction(ctx, func(tx *gorm.DB) error {
+		modelPlayer, err := p.findPlayer(tx, groupID, id)
+		if err != nil {
+			return err
+		}
+		player := toDomainPlayer(*modelPlayer)
+		player.Apply(update)
+
+		positions, err := p.getPositions(tx, *player)
+		if err != nil {
+			return err
+		}
+
+		stats := models.JSONB(player.Stats.ToMap())
+		modelPlayer.Name = player.Name
+		modelPlayer.Stats = &stats
+		modelPlayer.Overall = player.Overall()
+		if err := tx.Omit("Position").Save(modelPlayer).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(modelPlayer).Association("Position").Replace(positions); err != nil {
+			return err
+		}
+
+		updated, err = p.findPlayer(tx, groupID, id)
+		return err
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to update player", err, translated, slog.Uint64("id", uint64(id)))
+		return nil, translated
+	}
+	return toDomainPlayer(*updated), nil
+}
+
+// DeletePlayer faz o soft delete do jogador preenchendo DeletedAt.
+func (p *playerRepository) DeletePlayer(ctx context.Context, id uint) error {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return err
+	}
+
+	result := p.db.WithContext(ctx).Where("group_id = ?", groupID).Delete(&models.Player{}, id)
+	if result.Error != nil {
+		requestLogger(ctx, p.logger).Error("error when trying to delete player",
+			slog.Uint64("id", uint64(id)),
+			slog.String("error", result.Error.Error()),
+		)
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return appErr.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateStats grava apenas stats e overall, usada quando a carta é recalculada a partir das notas.
+func (p *playerRepository) UpdateStats(ctx context.Context, id uint, stats domain.Stats) error {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return err
+	}
+
+	modelPlayer, err := p.findPlayer(p.db.WithContext(ctx), groupID, id)
+	if err != nil {
+		return err
+	}
+	return p.saveStats(ctx, modelPlayer, stats)
+}
+
+// UpdateStoredStats é o UpdateStats da migração de stats, que percorre os jogadores de
+// todos os grupos.
+func (p *playerRepository) UpdateStoredStats(ctx context.Context, id uint, stats domain.Stats) error {
+	var modelPlayer models.Player
+	if err := p.db.WithContext(ctx).Preload("Position").First(&modelPlayer, id).Error; err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to fetch player", err, translated, slog.Uint64("id", uint64(id)))
+		return translated
+	}
+	return p.saveStats(ctx, &modelPlayer, stats)
+}
+
+func (p *playerRepository) saveStats(ctx context.Context, modelPlayer *models.Player, stats domain.Stats) error {
+	id := modelPlayer.ID
+	player := toDomainPlayer(*modelPlayer)
+	player.Stats = stats
+
+	jsonb := models.JSONB(stats.ToMap())
+	err := p.db.WithContext(ctx).Model(modelPlayer).Updates(map[string]interface{}{
+		"stats":   &jsonb,
+		"overall": player.Overall(),
+	}).Error
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to update player stats", err, translated, slog.Uint64("id", uint64(id)))
+		return translated
+	}
+	return nil
+}
+
+// EachStoredStats passa a fn, em lotes por ID, o JSONB de stats de cada jogador sem
+// conversão, para auditar linhas legadas sem carregar a tabela inteira na memória.
+func (p *playerRepository) EachStoredStats(ctx context.Context, fn func([]domain.StoredStats) error) error {
+	query := p.db.Select("id", "name", "stats")
+	err := database.Batch(ctx, query, database.BatchOptions{Size: storedStatsBatchSize}, func(_ *gorm.DB, batch []models.Player) error {
+		stored := make([]domain.StoredStats, len(batch))
+		for i, mp := range batch {
+			stored[i] = domain.StoredStats{PlayerID: mp.ID, Name: mp.Name, Raw: rawStats(mp.Stats)}
+		}
+		return fn(stored)
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to fetch stored stats", err, translated)
+		return translated
+	}
+	return nil
+}
 
+func (p *playerRepository) findPlayer(db *gorm.DB, groupID, id uint) (*models.Player, error) {
+	var modelPlayer models.Player
+	if err := db.Preload("Position").Where("group_id = ?", groupID).First(&modelPlayer, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErr.ErrNotFound
+		}
+		requestLogger(db.Statement.Context, p.logger).Error("error when trying to fetch player",
+			slog.Uint64("id", uint64(id)),
+			slog.String("error", err.Error()),
+		)
+		return nil, translateError(err)
+	}
+	return &modelPlayer, nil
+}
+
+func toDomainPlayer(m models.Player) *domain.Player {
 	return &domain.Player{
-		ID:       modelPlayer.ID,
-		Name:     modelPlayer.Name,
-		Stats:    map[string]interface{}(*modelPlayer.Stats),
-		Position: extractPositionNames(modelPlayer.Position),
-	}, nil
+		ID:       m.ID,
+		Name:     m.Name,
+		Stats:    domain.StatsFromMap(rawStats(m.Stats)),
+		Position: extractPositionNames(m.Position),
+	}
+}
+
+func rawStats(stats *models.JSONB) map[string]interface{} {
+	if stats == nil {
+		return nil
+	}
+	return map[string]interface{}(*stats)
 }
 
 func extractPositionNames(positions []models.Position) []string {
@@ -72,58 +320,62 @@ func extractPositionNames(positions []models.Position) []string {
 	return names
 }
 
-//func (p *playerRepository) GetPlayers() []models.Player {
-//	var players []models.Player
-//	p.db.Find(&players)
-//
-//	return players
-//}
-
-//func (p *playerRepository) GetPlayerByID(id uint) (*models.Player, error) {
-//	var player models.Player
-//	err := p.db.First(&player, id).Error
-//
-//	return &player, err
-//}
-
-//func (p *playerRepository) UpdatePlayer(player domain.Player) error {
-//	// Convert domain.Player to models.Player for database update
-//	modelPlayer := models.Player{
-//		Model: database.Model{ID: player.ID},
-//		Name:  player.Name,
-//		Stats: models.JSONB(player.Stats),
-//	}
-//
-//	// Get positions for the player
-//	positions, err := p.getPositions(player)
-//	if err != nil {
-//		return err
-//	}
-//	modelPlayer.Position = positions
-//
-//	return p.db.Save(&modelPlayer).Error
-//}
-
-//func (p *playerRepository) DeletePlayer(id uint) error {
-//	return p.db.Delete(&models.Player{}, id).Error
-//}
-
-func (p *playerRepository) getPositions(player domain.Player) ([]models.Position, error) {
-	var positions []models.Position
-	for _, posName := range player.Position {
-		var position models.Position
-		if err := p.db.Where("name = ?", posName).First(&position).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				p.logger.Warn("position not founded", slog.String("name", posName))
-				return nil, fmt.Errorf("position '%s' not found", posName)
-			}
-			p.logger.Error("error while fetching position",
-				slog.String("name", posName),
-				slog.String("error", err.Error()),
-			)
-			return nil, fmt.Errorf("error while fetching position '%s': %w", posName, err)
+// createPlayer grava o jogador e suas posições no grupo em tx e devolve o registro relido;
+// quem chama abre a transação.
+func (p *playerRepository) createPlayer(tx *gorm.DB, groupID uint, player domain.Player) (*models.Player, error) {
+	positions, err := p.getPositions(tx, player)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := models.JSONB(player.Stats.ToMap())
+	modelPlayer := models.Player{
+		GroupID:  groupID,
+		Name:     player.Name,
+		Stats:    &stats,
+		Overall:  player.Overall(),
+		Position: positions,
+	}
+	if err := tx.Create(&modelPlayer).Error; err != nil {
+		return nil, err
+	}
+	return p.findPlayer(tx, groupID, modelPlayer.ID)
+}
+
+// getPositions busca todas as posições do jogador, por nome ou sigla, numa única consulta.
+// Cada posição inexistente vira uma entrada própria em ValidationErrors.
+func (p *playerRepository) getPositions(db *gorm.DB, player domain.Player) ([]models.Position, error) {
+	var found []models.Position
+	err := db.Where("name IN ? OR abbreviation IN ?", player.Position, player.Position).Find(&found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]models.Position, 2*len(found))
+	for _, position := range found {
+		byKey[position.Name] = position
+		if position.Abbreviation != nil {
+			byKey[*position.Abbreviation] = position
+		}
+	}
+
+	var errs appErr.ValidationErrors
+	positions := make([]models.Position, 0, len(player.Position))
+	seen := make(map[uint]bool, len(player.Position))
+	for _, name := range player.Position {
+		position, ok := byKey[name]
+		if !ok {
+			errs.Append("positions", fmt.Sprintf("Position '%s' not found", name))
+			continue
+		}
+		if !seen[position.ID] {
+			seen[position.ID] = true
+			positions = append(positions, position)
 		}
-		positions = append(positions, position)
+	}
+	if errs.HasErrors() {
+		requestLogger(db.Statement.Context, p.logger).Warn("positions not found", slog.Any("positions", player.Position))
+		return nil, &errs
 	}
 	return positions, nil
 }
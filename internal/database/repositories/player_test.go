@@ -1,11 +1,15 @@
 package repositories
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"testing"
 
+	"fut-app/internal/database"
 	"fut-app/internal/database/models"
 	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -28,6 +32,11 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	return db
 }
 
+// groupCtx é o contexto de uma requisição do grupo 1, onde os testes gravam os dados.
+func groupCtx() context.Context {
+	return domain.WithMembership(context.Background(), domain.Membership{GroupID: 1})
+}
+
 func setupTestDBWithPositions(t *testing.T) (*gorm.DB, []models.Position) {
 	db := setupTestDB(t)
 
@@ -51,15 +60,9 @@ func setupTestDBWithPositions(t *testing.T) (*gorm.DB, []models.Position) {
 func TestPlayerRepository_CreateAndGetPlayer(t *testing.T) {
 	db, _ := setupTestDBWithPositions(t)
 	logger := slog.Default()
-	repo := NewPlayer(db, logger)
+	repo := NewPlayer(&database.Database{DB: db}, logger)
 
-	stats := make(map[string]interface{})
-	stats["velocidade"] = 99
-	stats["drible"] = 95
-	stats["finalizacao"] = 90
-	stats["passe"] = 88
-	stats["defesa"] = 60
-	stats["fisico"] = 85
+	stats := newTestStats()
 
 	player := domain.Player{
 		Name:     "Sócrates",
@@ -67,7 +70,7 @@ func TestPlayerRepository_CreateAndGetPlayer(t *testing.T) {
 		Position: []string{"Meio-campo"},
 	}
 
-	createdPlayer, err := repo.CreatePlayer(player)
+	createdPlayer, err := repo.CreatePlayer(groupCtx(), player)
 	if err != nil {
 		t.Fatalf("CreatePlayer() error = %v", err)
 	}
@@ -84,15 +87,9 @@ func TestPlayerRepository_CreateAndGetPlayer(t *testing.T) {
 func TestPlayerRepository_CreatePlayerWithMultiplePositions(t *testing.T) {
 	db, _ := setupTestDBWithPositions(t)
 	logger := slog.Default()
-	repo := NewPlayer(db, logger)
+	repo := NewPlayer(&database.Database{DB: db}, logger)
 
-	stats := make(map[string]interface{})
-	stats["velocidade"] = 99
-	stats["drible"] = 95
-	stats["finalizacao"] = 90
-	stats["passe"] = 88
-	stats["defesa"] = 60
-	stats["fisico"] = 85
+	stats := newTestStats()
 
 	player := domain.Player{
 		Name:     "Pelé",
@@ -100,7 +97,7 @@ func TestPlayerRepository_CreatePlayerWithMultiplePositions(t *testing.T) {
 		Position: []string{"Atacante", "Meio-campo"},
 	}
 
-	createdPlayer, err := repo.CreatePlayer(player)
+	createdPlayer, err := repo.CreatePlayer(groupCtx(), player)
 	if err != nil {
 		t.Fatalf("CreatePlayer() error = %v", err)
 	}
@@ -127,29 +124,347 @@ func TestPlayerRepository_CreatePlayerWithMultiplePositions(t *testing.T) {
 func TestPlayerRepository_CreatePlayerWithInvalidPosition(t *testing.T) {
 	db, _ := setupTestDBWithPositions(t)
 	logger := slog.Default()
-	repo := NewPlayer(db, logger)
+	repo := NewPlayer(&database.Database{DB: db}, logger)
 
-	stats := make(map[string]interface{})
-	stats["velocidade"] = 99
-	stats["drible"] = 95
-	stats["finalizacao"] = 90
-	stats["passe"] = 88
-	stats["defesa"] = 60
-	stats["fisico"] = 85
+	stats := newTestStats()
 
 	player := domain.Player{
 		Name:     "Test Player",
 		Stats:    stats,
-		Position: []string{"Posição Inexistente"},
+		Position: []string{"Posição Inexistente", "Atacante", "Líbero"},
+	}
+
+	_, err := repo.CreatePlayer(groupCtx(), player)
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("CreatePlayer() error = %v, want ValidationErrors", err)
+	}
+
+	expected := []string{"Position 'Posição Inexistente' not found", "Position 'Líbero' not found"}
+	if len(*ve) != len(expected) {
+		t.Fatalf("CreatePlayer() errors = %v, want one per missing position", *ve)
+	}
+	for i, message := range expected {
+		if (*ve)[i].Field != "positions" || (*ve)[i].Message != message {
+			t.Errorf("CreatePlayer() error[%d] = %+v, want %s", i, (*ve)[i], message)
+		}
+	}
+
+	var count int64
+	db.Model(&models.Player{}).Count(&count)
+	if count != 0 {
+		t.Errorf("players count = %d, want 0", count)
 	}
+}
+
+func TestPlayerRepository_CreatePlayer_PositionByAbbreviation(t *testing.T) {
+	db, positions := setupTestDBWithPositions(t)
+	abbreviation := "ST"
+	if err := db.Model(&positions[0]).Update("abbreviation", abbreviation).Error; err != nil {
+		t.Fatalf("failed to set abbreviation: %v", err)
+	}
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
 
-	_, err := repo.CreatePlayer(player)
-	if err == nil {
-		t.Errorf("CreatePlayer() should return error for invalid position, got nil")
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Romário", Stats: newTestStats(), Position: []string{"ST", "Atacante", "Zagueiro"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
 	}
+	if len(created.Position) != 2 || created.Position[0] != "Atacante" || created.Position[1] != "Zagueiro" {
+		t.Errorf("CreatePlayer() positions = %v, want [Atacante Zagueiro]", created.Position)
+	}
+}
 
-	expectedError := "position 'Posição Inexistente' not found"
-	if err.Error() != expectedError {
-		t.Errorf("CreatePlayer() error = %v, want %s", err, expectedError)
+func newTestStats() domain.Stats {
+	return domain.Stats{Finishing: 70, Passing: 85, Speed: 80, Defense: 60, Stamina: 78, Highlight: 75}
+}
+
+func TestPlayerRepository_GetPlayerByID(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Zico", Stats: newTestStats(), Position: []string{"Meio-campo"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	got, err := repo.GetPlayerByID(groupCtx(), created.ID)
+	if err != nil {
+		t.Fatalf("GetPlayerByID() error = %v", err)
+	}
+	if got.Name != "Zico" || len(got.Position) != 1 || got.Position[0] != "Meio-campo" {
+		t.Errorf("GetPlayerByID() = %+v", got)
+	}
+	if got.Stats != newTestStats() {
+		t.Errorf("GetPlayerByID() stats = %+v, want %+v", got.Stats, newTestStats())
+	}
+}
+
+func TestPlayerRepository_GetPlayerByID_NotFound(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	_, err := repo.GetPlayerByID(groupCtx(), 404)
+	if !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("GetPlayerByID() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPlayerRepository_CreatePlayer_CanceledContext(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	ctx, cancel := context.WithCancel(groupCtx())
+	cancel()
+	_, err := repo.CreatePlayer(ctx, domain.Player{Name: "Zico", Stats: newTestStats(), Position: []string{"Meio-campo"}})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("CreatePlayer() error = %v, want context.Canceled", err)
+	}
+
+	page, err := repo.ListPlayers(groupCtx(), domain.PageRequest{})
+	if err != nil {
+		t.Fatalf("ListPlayers() error = %v", err)
+	}
+	if page.Total != 0 {
+		t.Errorf("ListPlayers() = %+v, want no player after a canceled create", page)
+	}
+}
+
+func TestPlayerRepository_UpdatePlayer(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Careca", Stats: newTestStats(), Position: []string{"Meio-campo"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	updated, err := repo.UpdatePlayer(groupCtx(), created.ID, domain.PlayerUpdate{
+		Name:     "Careca II",
+		Position: []string{"Atacante", "Zagueiro"},
+	})
+	if err != nil {
+		t.Fatalf("UpdatePlayer() error = %v", err)
+	}
+	if updated.Name != "Careca II" {
+		t.Errorf("UpdatePlayer() name = %v, want Careca II", updated.Name)
+	}
+	if len(updated.Position) != 2 {
+		t.Errorf("UpdatePlayer() positions = %v, want [Atacante Zagueiro]", updated.Position)
+	}
+	if updated.Stats != created.Stats {
+		t.Errorf("UpdatePlayer() without stats = %+v, want the stored %+v", updated.Stats, created.Stats)
+	}
+
+	stats := newTestStats()
+	stats.Finishing = 99
+	updated, err = repo.UpdatePlayer(groupCtx(), created.ID, domain.PlayerUpdate{Name: "Careca II", Position: []string{"Atacante"}, Stats: &stats})
+	if err != nil || updated.Stats.Finishing != 99 {
+		t.Errorf("UpdatePlayer() with stats = %+v, %v, want finishing 99", updated, err)
+	}
+}
+
+func TestPlayerRepository_UpdatePlayer_NotFound(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	_, err := repo.UpdatePlayer(groupCtx(), 404, domain.PlayerUpdate{Name: "Ghost", Position: []string{"Atacante"}})
+	if !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("UpdatePlayer() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPlayerRepository_DeletePlayer_SoftDelete(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Dinamite", Stats: newTestStats(), Position: []string{"Atacante"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	if err := repo.DeletePlayer(groupCtx(), created.ID); err != nil {
+		t.Fatalf("DeletePlayer() error = %v", err)
+	}
+
+	if _, err := repo.GetPlayerByID(groupCtx(), created.ID); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("GetPlayerByID() after delete error = %v, want ErrNotFound", err)
+	}
+
+	var stored models.Player
+	if err := db.Unscoped().First(&stored, created.ID).Error; err != nil {
+		t.Fatalf("soft deleted row should remain: %v", err)
+	}
+	if !stored.DeletedAt.Valid {
+		t.Error("DeletePlayer() should set DeletedAt")
+	}
+
+	if err := repo.DeletePlayer(groupCtx(), created.ID); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("DeletePlayer() twice error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPlayerRepository_UpdateStats(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Zico", Stats: newTestStats(), Position: []string{"Meio-campo"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	derived := domain.Stats{Finishing: 90, Passing: 88, Speed: 80, Defense: 55, Stamina: 75, Highlight: 92}
+	if err := repo.UpdateStats(groupCtx(), created.ID, derived); err != nil {
+		t.Fatalf("UpdateStats() error = %v", err)
+	}
+
+	got, err := repo.GetPlayerByID(groupCtx(), created.ID)
+	if err != nil {
+		t.Fatalf("GetPlayerByID() error = %v", err)
+	}
+	if got.Stats != derived {
+		t.Errorf("UpdateStats() stats = %v", got.Stats)
+	}
+
+	if err := repo.UpdateStats(groupCtx(), 404, derived); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("UpdateStats() missing player error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPlayerRepository_EachStoredStats(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Zico", Stats: newTestStats(), Position: []string{"Meio-campo"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+	legacy := models.JSONB{"velocidade": 80, "drible": 75}
+	if err := db.Model(&models.Player{}).Where("id = ?", created.ID).Update("stats", &legacy).Error; err != nil {
+		t.Fatalf("failed to write legacy stats: %v", err)
+	}
+
+	if _, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Sócrates", Stats: newTestStats(), Position: []string{"Meio-campo"}}); err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+
+	previous := storedStatsBatchSize
+	storedStatsBatchSize = 1
+	t.Cleanup(func() { storedStatsBatchSize = previous })
+
+	// A migração de stats percorre todos os grupos, então roda sem grupo na requisição.
+	var batches [][]domain.StoredStats
+	err = repo.EachStoredStats(context.Background(), func(batch []domain.StoredStats) error {
+		batches = append(batches, batch)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("EachStoredStats() error = %v", err)
+	}
+	if len(batches) != 2 || len(batches[0]) != 1 {
+		t.Fatalf("EachStoredStats() batches = %+v, want one player per batch", batches)
+	}
+	stored := batches[0][0]
+	if stored.PlayerID != created.ID || stored.Name != "Zico" {
+		t.Fatalf("EachStoredStats() first = %+v", stored)
+	}
+	if len(stored.Raw) != 2 || stored.Raw["velocidade"] != float64(80) {
+		t.Errorf("EachStoredStats() raw = %v, want the legacy keys untouched", stored.Raw)
+	}
+}
+
+func TestPlayerRepository_GetPlayersByIDs(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	var ids []uint
+	for _, name := range []string{"Zico", "Falcão", "Éder"} {
+		p, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: name, Stats: newTestStats(), Position: []string{"Meio-campo"}})
+		if err != nil {
+			t.Fatalf("CreatePlayer() error = %v", err)
+		}
+		ids = append(ids, p.ID)
+	}
+
+	players, err := repo.GetPlayersByIDs(groupCtx(), []uint{ids[0], ids[2], 404})
+	if err != nil {
+		t.Fatalf("GetPlayersByIDs() error = %v", err)
+	}
+	if len(players) != 2 || players[0].Name != "Zico" || players[1].Name != "Éder" {
+		t.Errorf("GetPlayersByIDs() = %+v", players)
+	}
+}
+
+func TestPlayerRepository_ListPlayers(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	strong := domain.Stats{Finishing: 95, Passing: 90, Speed: 90, Defense: 60, Stamina: 85, Highlight: 95}
+	players := []domain.Player{
+		{Name: "Zico", Stats: strong, Position: []string{"Meio-campo"}},
+		{Name: "Zinho", Stats: newTestStats(), Position: []string{"Meio-campo", "Atacante"}},
+		{Name: "Zé Roberto", Stats: newTestStats(), Position: []string{"Zagueiro"}},
+		{Name: "Taffarel", Stats: newTestStats(), Position: []string{"Goleiro"}},
+	}
+	for _, player := range players {
+		if _, err := repo.CreatePlayer(groupCtx(), player); err != nil {
+			t.Fatalf("CreatePlayer() error = %v", err)
+		}
+	}
+
+	page, err := repo.ListPlayers(groupCtx(), domain.PageRequest{
+		Search:   "zi",
+		Sort:     "-overall",
+		PageSize: 1,
+		Filters:  map[string]string{"position": "meio-campo"},
+	})
+	if err != nil {
+		t.Fatalf("ListPlayers() error = %v", err)
+	}
+	if page.Total != 2 || page.TotalPages != 2 || len(page.Items) != 1 || page.Items[0].Name != "Zico" {
+		t.Fatalf("ListPlayers() = %+v", page)
+	}
+	if len(page.Items[0].Position) != 1 {
+		t.Errorf("ListPlayers() should preload positions, got %v", page.Items[0].Position)
+	}
+
+	all, err := repo.ListPlayers(groupCtx(), domain.PageRequest{})
+	if err != nil {
+		t.Fatalf("ListPlayers() error = %v", err)
+	}
+	if all.Total != 4 || all.Items[0].Name != "Taffarel" {
+		t.Errorf("ListPlayers() default sort = %+v, want by name", all.Items)
+	}
+}
+
+func TestPlayerRepository_ListPlayers_InvalidSort(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	_, err := repo.ListPlayers(groupCtx(), domain.PageRequest{Sort: "stats"})
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) || (*ve)[0].Field != "sort" {
+		t.Errorf("ListPlayers() error = %v, want sort validation error", err)
+	}
+}
+
+func TestPlayerRepository_UpdateStats_RecomputesOverall(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreatePlayer(groupCtx(), domain.Player{Name: "Aldair", Stats: newTestStats(), Position: []string{"Zagueiro"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+	derived := domain.Stats{Finishing: 50, Passing: 70, Speed: 70, Defense: 95, Stamina: 80, Highlight: 70}
+	if err := repo.UpdateStats(groupCtx(), created.ID, derived); err != nil {
+		t.Fatalf("UpdateStats() error = %v", err)
+	}
+
+	var stored models.Player
+	if err := db.First(&stored, created.ID).Error; err != nil {
+		t.Fatalf("failed to load player: %v", err)
+	}
+	if want := derived.Overall(domain.LineDefense); stored.Overall != want {
+		t.Errorf("UpdateStats() overall = %d, want %d", stored.Overall, want)
 	}
 }
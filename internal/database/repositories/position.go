@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+
+	"gorm.io/gorm"
+)
+
+type (
+	positionRepository struct {
+		db     *gorm.DB
+		logger *slog.Logger
+	}
+	Position interface {
+		CreatePosition(context.Context, domain.Position) (*domain.Position, error)
+		GetPositions(context.Context) ([]domain.Position, error)
+		GetPositionByID(context.Context, uint) (*domain.Position, error)
+		UpdatePosition(context.Context, domain.Position) (*domain.Position, error)
+		DeletePosition(context.Context, uint) error
+		SeedPositions(context.Context, []domain.Position) error
+	}
+)
+
+func NewPosition(DB *gorm.DB, l *slog.Logger) Position {
+	return &positionRepository{
+		db:     DB,
+		logger: l,
+	}
+}
+
+func (p *positionRepository) CreatePosition(ctx context.Context, position domain.Position) (*domain.Position, error) {
+	modelPosition := toModelPosition(position)
+	if err := p.db.WithContext(ctx).Create(&modelPosition).Error; err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to create position", err, translated, slog.String("name", position.Name))
+		return nil, translated
+	}
+	return toDomainPosition(modelPosition), nil
+}
+
+func (p *positionRepository) GetPositions(ctx context.Context) ([]domain.Position, error) {
+	var modelPositions []models.Position
+	if err := p.db.WithContext(ctx).Order("id").Find(&modelPositions).Error; err != nil {
+		requestLogger(ctx, p.logger).Error("error when trying to list positions", slog.String("error", err.Error()))
+		return nil, translateError(err)
+	}
+
+	positions := make([]domain.Position, len(modelPositions))
+	for i, mp := range modelPositions {
+		positions[i] = *toDomainPosition(mp)
+	}
+	return positions, nil
+}
+
+func (p *positionRepository) GetPositionByID(ctx context.Context, id uint) (*domain.Position, error) {
+	modelPosition, err := p.findPosition(p.db.WithContext(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPosition(*modelPosition), nil
+}
+
+func (p *positionRepository) UpdatePosition(ctx context.Context, position domain.Position) (*domain.Position, error) {
+	modelPosition, err := p.findPosition(p.db.WithContext(ctx), position.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := toModelPosition(position)
+	updated.Model = modelPosition.Model
+	if err := p.db.WithContext(ctx).Save(&updated).Error; err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, p.logger), "error when trying to update position", err, translated, slog.Uint64("id", uint64(position.ID)))
+		return nil, translated
+	}
+	return toDomainPosition(updated), nil
+}
+
+// DeletePosition remove a posição de vez, para que o nome e a sigla possam ser reutilizados.
+// Posições de jogadores ativos não podem ser removidas; vínculos com jogadores já
+// removidos são apagados junto.
+func (p *positionRepository) DeletePosition(ctx context.Context, id uint) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := p.findPosition(tx, id); err != nil {
+			return err
+		}
+
+		var inUse int64
+		err := tx.Table("player_positions").
+			Joins("JOIN players ON players.id = player_positions.player_id").
+			Where("player_positions.position_id = ? AND players.deleted_at IS NULL", id).
+			Count(&inUse).Error
+		if err != nil {
+			return err
+		}
+		if inUse > 0 {
+			var errs appErr.ValidationErrors
+			errs.Append("position", fmt.Sprintf("Position is used by %d players", inUse))
+			return &errs
+		}
+
+		if err := tx.Exec("DELETE FROM player_positions WHERE position_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&models.Position{}, id).Error
+	})
+	if err != nil {
+		var ve *appErr.ValidationErrors
+		if !errors.Is(err, appErr.ErrNotFound) && !errors.As(err, &ve) {
+			requestLogger(ctx, p.logger).Error("error when trying to delete position",
+				slog.Uint64("id", uint64(id)),
+				slog.String("error", err.Error()),
+			)
+		}
+		return translateError(err)
+	}
+	return nil
+}
+
+// SeedPositions cria as posições que ainda não existem, procurando pelo nome ou pela sigla.
+// Posições antigas encontradas pelo nome ganham a sigla e o setor que estiverem faltando.
+func (p *positionRepository) SeedPositions(ctx context.Context, positions []domain.Position) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, position := range positions {
+			var existing models.Position
+			err := tx.Where("name = ? OR abbreviation = ?", position.Name, position.Abbreviation).First(&existing).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				seed := toModelPosition(position)
+				if err := tx.Create(&seed).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if existing.Abbreviation == nil || existing.Line == nil {
+				if existing.Abbreviation == nil {
+					existing.Abbreviation = &position.Abbreviation
+				}
+				if existing.Line == nil {
+					line := string(position.Line)
+					existing.Line = &line
+				}
+				if err := tx.Save(&existing).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		requestLogger(ctx, p.logger).Error("error when trying to seed positions", slog.String("error", err.Error()))
+		return translateError(err)
+	}
+	return nil
+}
+
+func (p *positionRepository) findPosition(db *gorm.DB, id uint) (*models.Position, error) {
+	var modelPosition models.Position
+	if err := db.First(&modelPosition, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, appErr.ErrNotFound
+		}
+		requestLogger(db.Statement.Context, p.logger).Error("error when trying to fetch position",
+			slog.Uint64("id", uint64(id)),
+			slog.String("error", err.Error()),
+		)
+		return nil, translateError(err)
+	}
+	return &modelPosition, nil
+}
+
+func toModelPosition(p domain.Position) models.Position {
+	abbreviation := p.Abbreviation
+	line := string(p.Line)
+	return models.Position{
+		Name:         p.Name,
+		Abbreviation: &abbreviation,
+		Line:         &line,
+	}
+}
+
+func toDomainPosition(m models.Position) *domain.Position {
+	position := &domain.Position{ID: m.ID, Name: m.Name}
+	if m.Abbreviation != nil {
+		position.Abbreviation = *m.Abbreviation
+	}
+	if m.Line != nil {
+		position.Line = domain.Line(*m.Line)
+	}
+	return position
+}
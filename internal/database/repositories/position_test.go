@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+)
+
+func TestPositionRepository_SeedPositions_Idempotent(t *testing.T) {
+	db, _ := setupTestDBWithPositions(t)
+	repo := NewPosition(db, slog.Default())
+
+	for i := 0; i < 2; i++ {
+		if err := repo.SeedPositions(context.Background(), domain.StandardPositions); err != nil {
+			t.Fatalf("SeedPositions() run %d error = %v", i+1, err)
+		}
+	}
+
+	positions, err := repo.GetPositions(context.Background())
+	if err != nil {
+		t.Fatalf("GetPositions() error = %v", err)
+	}
+	// As quatro posições do setup já existem pelo nome (Atacante, Meio-campo, Zagueiro e
+	// Goleiro) e só ganham sigla e setor; as outras seis são criadas.
+	if len(positions) != 10 {
+		t.Fatalf("GetPositions() count = %d, want 10", len(positions))
+	}
+	for _, p := range positions {
+		if p.Abbreviation == "" || !p.Line.IsValid() {
+			t.Errorf("SeedPositions() left %+v incomplete", p)
+		}
+	}
+}
+
+func TestPositionRepository_CreateAndUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPosition(db, slog.Default())
+
+	created, err := repo.CreatePosition(context.Background(), domain.Position{Name: "Líbero", Abbreviation: "LIB", Line: domain.LineDefense})
+	if err != nil {
+		t.Fatalf("CreatePosition() error = %v", err)
+	}
+	if created.ID == 0 || created.Line != domain.LineDefense {
+		t.Fatalf("CreatePosition() = %+v", created)
+	}
+
+	created.Name = "Líbero clássico"
+	updated, err := repo.UpdatePosition(context.Background(), *created)
+	if err != nil {
+		t.Fatalf("UpdatePosition() error = %v", err)
+	}
+	if updated.Name != "Líbero clássico" || updated.Abbreviation != "LIB" {
+		t.Errorf("UpdatePosition() = %+v", updated)
+	}
+
+	if _, err := repo.UpdatePosition(context.Background(), domain.Position{ID: 404, Name: "X", Abbreviation: "X", Line: domain.LineGoal}); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("UpdatePosition() missing error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPositionRepository_CreatePosition_Duplicate(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewPosition(db, slog.Default())
+
+	if _, err := repo.CreatePosition(context.Background(), domain.Position{Name: "Líbero", Abbreviation: "LIB", Line: domain.LineDefense}); err != nil {
+		t.Fatalf("CreatePosition() error = %v", err)
+	}
+
+	_, err := repo.CreatePosition(context.Background(), domain.Position{Name: "Outro", Abbreviation: "LIB", Line: domain.LineDefense})
+	if !errors.Is(err, appErr.ErrAlreadyExists) {
+		t.Fatalf("CreatePosition() error = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestPositionRepository_DeletePosition_InUse(t *testing.T) {
+	db, positions := setupTestDBWithPositions(t)
+	repo := NewPosition(db, slog.Default())
+	players := NewPlayer(&database.Database{DB: db}, slog.Default())
+
+	player, err := players.CreatePlayer(groupCtx(), domain.Player{Name: "Zico", Stats: newTestStats(), Position: []string{"Meio-campo"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+	midfield := positions[1].ID
+
+	var ve *appErr.ValidationErrors
+	if err := repo.DeletePosition(context.Background(), midfield); !errors.As(err, &ve) {
+		t.Fatalf("DeletePosition() in use error = %v, want validation error", err)
+	}
+
+	if err := players.DeletePlayer(groupCtx(), player.ID); err != nil {
+		t.Fatalf("DeletePlayer() error = %v", err)
+	}
+	if err := repo.DeletePosition(context.Background(), midfield); err != nil {
+		t.Fatalf("DeletePosition() after soft-deleting the player error = %v", err)
+	}
+
+	var count int64
+	db.Unscoped().Model(&models.Position{}).Where("id = ?", midfield).Count(&count)
+	if count != 0 {
+		t.Error("DeletePosition() should remove the row so the name can be reused")
+	}
+	if err := repo.DeletePosition(context.Background(), midfield); !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("DeletePosition() twice error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPlayerRepository_CreatePlayer_ByAbbreviation(t *testing.T) {
+	db := setupTestDB(t)
+	if err := NewPosition(db, slog.Default()).SeedPositions(context.Background(), domain.StandardPositions); err != nil {
+		t.Fatalf("SeedPositions() error = %v", err)
+	}
+
+	player, err := NewPlayer(&database.Database{DB: db}, slog.Default()).CreatePlayer(groupCtx(), domain.Player{Name: "Romário", Stats: newTestStats(), Position: []string{"ST"}})
+	if err != nil {
+		t.Fatalf("CreatePlayer() error = %v", err)
+	}
+	if len(player.Position) != 1 || player.Position[0] != "Atacante" {
+		t.Errorf("CreatePlayer() positions = %v, want [Atacante]", player.Position)
+	}
+}
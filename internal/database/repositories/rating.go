@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+
+	"gorm.io/gorm"
+)
+
+type (
+	ratingRepository struct {
+		db     *database.Database
+		logger *slog.Logger
+	}
+	Rating interface {
+		CreateRatings(context.Context, []domain.Rating) ([]domain.Rating, error)
+		GetRatedPlayerIDs(ctx context.Context, matchID, raterID uint) ([]uint, error)
+		GetRatingsReceived(ctx context.Context, playerID uint) ([]domain.Rating, error)
+	}
+)
+
+// NewRating recebe o Database para repetir a gravação das notas quando submissões
+// simultâneas no fim da partida colidem em deadlock.
+func NewRating(DB *database.Database, l *slog.Logger) Rating {
+	return &ratingRepository{
+		db:     DB,
+		logger: l,
+	}
+}
+
+func (r *ratingRepository) CreateRatings(ctx context.Context, ratings []domain.Rating) ([]domain.Rating, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelRatings []models.Rating
+	err = r.db.Transaction(ctx, func(tx *gorm.DB) error {
+		// Recriado a cada tentativa para não reaproveitar IDs de uma transação desfeita.
+		modelRatings = make([]models.Rating, len(ratings))
+		for i, rating := range ratings {
+			modelRatings[i] = toModelRating(rating)
+			modelRatings[i].GroupID = groupID
+		}
+		return tx.Create(&modelRatings).Error
+	})
+	if err != nil {
+		translated := translateError(err)
+		logFailure(requestLogger(ctx, r.logger), "error when trying to create ratings", err, translated)
+		return nil, translated
+	}
+
+	created := make([]domain.Rating, len(modelRatings))
+	for i, mr := range modelRatings {
+		created[i] = toDomainRating(mr)
+	}
+	return created, nil
+}
+
+func (r *ratingRepository) GetRatedPlayerIDs(ctx context.Context, matchID, raterID uint) ([]uint, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint
+	err = r.db.WithContext(ctx).Model(&models.Rating{}).
+		Where("group_id = ? AND match_id = ? AND player_id = ?", groupID, matchID, raterID).
+		Pluck("rated_player_id", &ids).Error
+	if err != nil {
+		requestLogger(ctx, r.logger).Error("error when trying to fetch rated players",
+			slog.Uint64("match_id", uint64(matchID)),
+			slog.Uint64("rater_id", uint64(raterID)),
+			slog.String("error", err.Error()),
+		)
+		return nil, translateError(err)
+	}
+	return ids, nil
+}
+
+func (r *ratingRepository) GetRatingsReceived(ctx context.Context, playerID uint) ([]domain.Rating, error) {
+	groupID, err := requestGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var modelRatings []models.Rating
+	err = r.db.WithContext(ctx).Where("group_id = ? AND rated_player_id = ?", groupID, playerID).Order("id").Find(&modelRatings).Error
+	if err != nil {
+		requestLogger(ctx, r.logger).Error("error when trying to fetch received ratings",
+			slog.Uint64("player_id", uint64(playerID)),
+			slog.String("error", err.Error()),
+		)
+		return nil, translateError(err)
+	}
+
+	ratings := make([]domain.Rating, len(modelRatings))
+	for i, mr := range modelRatings {
+		ratings[i] = toDomainRating(mr)
+	}
+	return ratings, nil
+}
+
+func toModelRating(r domain.Rating) models.Rating {
+	return models.Rating{
+		MatchID:       r.MatchID,
+		PlayerID:      r.RaterID,
+		RatedPlayerID: r.RatedPlayerID,
+		Finishing:     r.Finishing,
+		Passing:       r.Passing,
+		Speed:         r.Speed,
+		Defense:       r.Defense,
+		Stamina:       r.Stamina,
+		Highlight:     r.Highlight,
+	}
+}
+
+func toDomainRating(m models.Rating) domain.Rating {
+	return domain.Rating{
+		ID:            m.ID,
+		MatchID:       m.MatchID,
+		RaterID:       m.PlayerID,
+		RatedPlayerID: m.RatedPlayerID,
+		Finishing:     m.Finishing,
+		Passing:       m.Passing,
+		Speed:         m.Speed,
+		Defense:       m.Defense,
+		Stamina:       m.Stamina,
+		Highlight:     m.Highlight,
+		CreatedAt:     m.CreatedAt,
+	}
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"fut-app/internal/database"
+	"fut-app/internal/database/models"
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+)
+
+func newRepoRating(matchID, raterID, ratedID uint) domain.Rating {
+	return domain.Rating{
+		MatchID:       matchID,
+		RaterID:       raterID,
+		RatedPlayerID: ratedID,
+		Finishing:     70,
+		Passing:       75,
+		Speed:         80,
+		Defense:       60,
+		Stamina:       85,
+		Highlight:     65,
+	}
+}
+
+func TestRatingRepository_CreateRatings(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	if err := db.AutoMigrate(&models.Rating{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := NewRating(&database.Database{DB: db}, slog.Default())
+
+	created, err := repo.CreateRatings(groupCtx(), []domain.Rating{
+		newRepoRating(1, ids[0], ids[1]),
+		newRepoRating(1, ids[0], ids[2]),
+	})
+	if err != nil {
+		t.Fatalf("CreateRatings() error = %v", err)
+	}
+	if len(created) != 2 || created[0].ID == 0 || created[0].RaterID != ids[0] {
+		t.Errorf("CreateRatings() = %+v", created)
+	}
+
+	rated, err := repo.GetRatedPlayerIDs(groupCtx(), 1, ids[0])
+	if err != nil {
+		t.Fatalf("GetRatedPlayerIDs() error = %v", err)
+	}
+	if len(rated) != 2 {
+		t.Errorf("GetRatedPlayerIDs() = %v, want 2 ids", rated)
+	}
+}
+
+func TestRatingRepository_CreateRatings_DuplicateAlreadyExists(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	if err := db.AutoMigrate(&models.Rating{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := NewRating(&database.Database{DB: db}, slog.Default())
+
+	if _, err := repo.CreateRatings(groupCtx(), []domain.Rating{newRepoRating(1, ids[0], ids[1])}); err != nil {
+		t.Fatalf("CreateRatings() error = %v", err)
+	}
+
+	_, err := repo.CreateRatings(groupCtx(), []domain.Rating{newRepoRating(1, ids[0], ids[1])})
+	if !errors.Is(err, appErr.ErrAlreadyExists) {
+		t.Fatalf("CreateRatings() error = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestRatingRepository_CreateRatings_CheckConstraintIsValidationError(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	if err := db.AutoMigrate(&models.Rating{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := NewRating(&database.Database{DB: db}, slog.Default())
+
+	rating := newRepoRating(1, ids[0], ids[1])
+	rating.Stamina = 120
+
+	_, err := repo.CreateRatings(groupCtx(), []domain.Rating{rating})
+	var ve *appErr.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("CreateRatings() error = %v, want *ValidationErrors", err)
+	}
+	if (*ve)[0].Field != "stamina" {
+		t.Errorf("CreateRatings() field = %v, want stamina", (*ve)[0].Field)
+	}
+
+	var count int64
+	db.Model(&models.Rating{}).Count(&count)
+	if count != 0 {
+		t.Errorf("ratings count = %d, want 0", count)
+	}
+}
+
+func TestRatingRepository_GetRatingsReceived(t *testing.T) {
+	db, ids := setupMatchTestDB(t)
+	if err := db.AutoMigrate(&models.Rating{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo := NewRating(&database.Database{DB: db}, slog.Default())
+
+	_, err := repo.CreateRatings(groupCtx(), []domain.Rating{
+		newRepoRating(1, ids[0], ids[1]),
+		newRepoRating(1, ids[2], ids[1]),
+		newRepoRating(1, ids[1], ids[0]),
+	})
+	if err != nil {
+		t.Fatalf("CreateRatings() error = %v", err)
+	}
+
+	received, err := repo.GetRatingsReceived(groupCtx(), ids[1])
+	if err != nil {
+		t.Fatalf("GetRatingsReceived() error = %v", err)
+	}
+	if len(received) != 2 || received[0].CreatedAt.IsZero() {
+		t.Errorf("GetRatingsReceived() = %+v, want 2 ratings with CreatedAt", received)
+	}
+}
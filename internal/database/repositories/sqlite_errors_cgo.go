@@ -0,0 +1,37 @@
+//go:build cgo
+
+package repositories
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// sqliteConstraintViolation classifica a violação pelo código estendido do SQLite, que só
+// traz o nome da constraint na mensagem.
+func sqliteConstraintViolation(err error) (string, violationKind) {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		name := sqliteErr.Error()
+		if i := strings.Index(name, ": "); i >= 0 {
+			name = name[i+2:]
+		}
+		switch sqliteErr.ExtendedCode {
+		case sqlite3.ErrConstraintCheck:
+			return name, checkViolation
+		case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+			return name, uniqueViolation
+		case sqlite3.ErrConstraintForeignKey:
+			return "", foreignKeyViolation
+		}
+	}
+	return "", noViolation
+}
+
+// isSQLiteIOError informa se o SQLite não conseguiu abrir ou ler o arquivo do banco.
+func isSQLiteIOError(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && (sqliteErr.Code == sqlite3.ErrCantOpen || sqliteErr.Code == sqlite3.ErrIoErr)
+}
@@ -0,0 +1,13 @@
+//go:build !cgo
+
+package repositories
+
+// Sem cgo o driver do SQLite não funciona, então não há erro dele para classificar.
+
+func sqliteConstraintViolation(error) (string, violationKind) {
+	return "", noViolation
+}
+
+func isSQLiteIOError(error) bool {
+	return false
+}
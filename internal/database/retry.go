@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type (
+	// RetryPolicy controla quantas vezes Execute repete uma operação que falhou por erro
+	// transitório e quanto espera entre as tentativas. MaxAttempts <= 1 desliga o retry.
+	RetryPolicy struct {
+		MaxAttempts int
+		BaseDelay   time.Duration
+		MaxDelay    time.Duration
+	}
+
+	// RetryStats acumula as tentativas repetidas desde que o Database foi aberto.
+	RetryStats struct {
+		Retries   int64
+		Exhausted int64
+	}
+
+	retryCounters struct {
+		retries   atomic.Int64
+		exhausted atomic.Int64
+	}
+)
+
+// backoff devolve uma espera aleatória entre zero e BaseDelay·2^(attempt-1), limitada a
+// MaxDelay ("full jitter"), para que transações que colidiram não tentem juntas de novo.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	ceiling := p.MaxDelay
+	if exp := p.BaseDelay << (attempt - 1); exp > 0 && (ceiling <= 0 || exp < ceiling) {
+		ceiling = exp
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling + 1)
+}
+
+// IsTransient informa se o erro costuma passar ao repetir a operação inteira: falha de
+// serialização (40001), deadlock (40P01), exceções de conexão (08xxx), shutdown do
+// servidor (57P01–57P03), erros do pgconn que garantem que nada foi enviado e, no SQLite,
+// banco ocupado ou travado.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", "40P01", "57P01", "57P02", "57P03":
+			return true
+		}
+		return strings.HasPrefix(pgErr.Code, "08")
+	}
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+	return sqliteBusy(err)
+}
+
+// retry executa operation até dar certo, falhar com erro não transitório, esgotar as
+// tentativas ou o contexto ser cancelado durante a espera.
+func retry(ctx context.Context, policy RetryPolicy, counters *retryCounters, logger *slog.Logger, operation func() error) error {
+	attempts := max(policy.MaxAttempts, 1)
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = operation(); err == nil || !IsTransient(err) {
+			return err
+		}
+		if attempt == attempts {
+			break
+		}
+
+		delay := policy.backoff(attempt)
+		counters.retries.Add(1)
+		logger.Warn("retrying database operation after transient error",
+			slog.Int("attempt", attempt),
+			slog.Duration("delay", delay),
+			slog.String("error", err.Error()),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+
+	counters.exhausted.Add(1)
+	logger.Error("database operation failed after retries",
+		slog.Int("attempts", attempts),
+		slog.String("error", err.Error()),
+	)
+	return fmt.Errorf("❌ maximum attempts exceeded: %w", err)
+}
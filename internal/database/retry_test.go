@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func retryDatabase(t *testing.T, policy RetryPolicy) *Database {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	return &Database{DB: db, retry: policy, logger: slog.Default()}
+}
+
+func deadlock() error {
+	return fmt.Errorf("create ratings: %w", &pgconn.PgError{Code: "40P01", Message: "deadlock detected"})
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"serialization failure", &pgconn.PgError{Code: "40001"}, true},
+		{"wrapped deadlock", deadlock(), true},
+		{"connection failure", &pgconn.PgError{Code: "08006"}, true},
+		{"admin shutdown", &pgconn.PgError{Code: "57P01"}, true},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, false},
+		{"sqlite busy", sqlite3.Error{Code: sqlite3.ErrBusy}, true},
+		{"sqlite constraint", sqlite3.Error{Code: sqlite3.ErrConstraint}, false},
+		{"record not found", gorm.ErrRecordNotFound, false},
+		{"context cancelled", context.Canceled, false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.want {
+				t.Errorf("IsTransient(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 25 * time.Millisecond}
+	for attempt, ceiling := range map[int]time.Duration{1: 10 * time.Millisecond, 2: 20 * time.Millisecond, 5: 25 * time.Millisecond} {
+		for i := 0; i < 50; i++ {
+			if d := policy.backoff(attempt); d < 0 || d > ceiling {
+				t.Fatalf("backoff(%d) = %v, want between 0 and %v", attempt, d, ceiling)
+			}
+		}
+	}
+}
+
+func TestDatabase_Execute_RetriesTransientErrors(t *testing.T) {
+	db := retryDatabase(t, RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond})
+	calls := 0
+
+	err := db.Execute(context.Background(), func(tx *gorm.DB) error {
+		calls++
+		if calls < 3 {
+			return deadlock()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if calls != 3 || db.RetryStats() != (RetryStats{Retries: 2}) {
+		t.Errorf("Execute() calls = %d, stats = %+v", calls, db.RetryStats())
+	}
+}
+
+func TestDatabase_Execute_DoesNotRetryPermanentErrors(t *testing.T) {
+	db := retryDatabase(t, RetryPolicy{MaxAttempts: 3})
+	calls := 0
+
+	err := db.Execute(context.Background(), func(tx *gorm.DB) error {
+		calls++
+		return gorm.ErrRecordNotFound
+	})
+	if !errors.Is(err, gorm.ErrRecordNotFound) || calls != 1 {
+		t.Errorf("Execute() error = %v after %d calls, want ErrRecordNotFound after 1", err, calls)
+	}
+}
+
+func TestDatabase_Execute_GivesUpAfterMaxAttempts(t *testing.T) {
+	db := retryDatabase(t, RetryPolicy{MaxAttempts: 2})
+	calls := 0
+
+	err := db.Execute(context.Background(), func(tx *gorm.DB) error {
+		calls++
+		return deadlock()
+	})
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "40P01" || calls != 2 {
+		t.Errorf("Execute() error = %v after %d calls, want the deadlock after 2", err, calls)
+	}
+	if db.RetryStats() != (RetryStats{Retries: 1, Exhausted: 1}) {
+		t.Errorf("RetryStats() = %+v", db.RetryStats())
+	}
+}
+
+func TestDatabase_Execute_StopsWaitingWhenContextIsCancelled(t *testing.T) {
+	db := retryDatabase(t, RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := db.Execute(ctx, func(tx *gorm.DB) error { return deadlock() })
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Execute() error = %v, want DeadlineExceeded", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Error("Execute() should stop the backoff when the context ends")
+	}
+}
+
+func TestDatabase_Transaction_RetriesWholeTransaction(t *testing.T) {
+	db := retryDatabase(t, RetryPolicy{MaxAttempts: 2})
+	if err := db.AutoMigrate(&batchItem{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	calls := 0
+
+	err := db.Transaction(context.Background(), func(tx *gorm.DB) error {
+		calls++
+		if err := tx.Create(&batchItem{Value: calls}).Error; err != nil {
+			return err
+		}
+		if calls == 1 {
+			return deadlock()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+
+	var items []batchItem
+	db.Find(&items)
+	if len(items) != 1 || items[0].Value != 2 {
+		t.Errorf("Transaction() rows = %+v, want only the second attempt committed", items)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !cgo
+
+package database
+
+// sqliteBusy nunca é verdadeiro sem cgo: o driver do SQLite só funciona com cgo, então
+// não há erro dele para classificar.
+func sqliteBusy(error) bool {
+	return false
+}
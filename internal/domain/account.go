@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"fut-app/internal/errors"
+)
+
+const (
+	MinPasswordLength = 8
+	// MaxPasswordLength é o limite do bcrypt, que ignora o que passa de 72 bytes.
+	MaxPasswordLength = 72
+
+	TokenTypeBearer = "Bearer"
+)
+
+// TokenKind separa o token de acesso do de refresh, para que um não sirva no lugar do outro.
+type TokenKind string
+
+const (
+	AccessToken  TokenKind = "access"
+	RefreshToken TokenKind = "refresh"
+)
+
+type (
+	// Account é o acesso de uma pessoa à API. O jogador dela em cada grupo vem de Membership.
+	Account struct {
+		ID           uint
+		Email        string
+		PasswordHash string
+		Role         Role
+		CreatedAt    time.Time
+	}
+
+	Registration struct {
+		Email    string
+		Password string
+	}
+
+	Credentials struct {
+		Email    string
+		Password string
+	}
+
+	// Principal identifica quem fez a requisição autenticada. Role não vai no token: vem da
+	// conta a cada requisição, para que uma troca de papel valha na hora.
+	Principal struct {
+		AccountID uint
+		Role      Role
+	}
+
+	// TokenClaims é o conteúdo de um token já verificado.
+	TokenClaims struct {
+		Principal
+		ID        string
+		Kind      TokenKind
+		ExpiresAt time.Time
+	}
+
+	TokenPair struct {
+		AccessToken  string
+		RefreshToken string
+		TokenType    string
+		// ExpiresIn é a validade do token de acesso.
+		ExpiresIn time.Duration
+	}
+
+	// Logout revoga o token de acesso da requisição e, se enviado, o refresh token.
+	Logout struct {
+		Access       TokenClaims
+		RefreshToken string
+	}
+)
+
+// NormalizeEmail compara e-mails sem diferenciar maiúsculas nem espaços nas pontas.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (r Registration) Validate() error {
+	var errs errors.ValidationErrors
+
+	// ParseAddress também aceita "Nome <email>"; só o endereço puro é válido aqui.
+	if addr, err := mail.ParseAddress(r.Email); err != nil || addr.Address != r.Email {
+		errs.Append("email", "Email must be a valid address")
+	}
+	switch {
+	case len(r.Password) < MinPasswordLength:
+		errs.Append("password", fmt.Sprintf("Password must have at least %d characters", MinPasswordLength))
+	case len(r.Password) > MaxPasswordLength:
+		errs.Append("password", fmt.Sprintf("Password must have at most %d bytes", MaxPasswordLength))
+	}
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal guarda em ctx os claims do token que autenticou a requisição.
+func WithPrincipal(ctx context.Context, claims TokenClaims) context.Context {
+	return context.WithValue(ctx, principalKey{}, claims)
+}
+
+// PrincipalFromContext devolve os claims da requisição autenticada; ok é false quando a
+// requisição não passou pelo middleware de autenticação.
+func PrincipalFromContext(ctx context.Context) (TokenClaims, bool) {
+	claims, ok := ctx.Value(principalKey{}).(TokenClaims)
+	return claims, ok
+}
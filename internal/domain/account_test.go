@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	apperrors "fut-app/internal/errors"
+)
+
+func validRegistration() Registration {
+	return Registration{
+		Email:    "doutor@fut.app",
+		Password: "calcanhar",
+	}
+}
+
+func TestRegistration_Validate_Success(t *testing.T) {
+	if err := validRegistration().Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestRegistration_Validate_Fields(t *testing.T) {
+	registration := validRegistration()
+	registration.Email = "Doutor <doutor@fut.app>"
+	registration.Password = strings.Repeat("x", MaxPasswordLength+1)
+
+	err := registration.Validate()
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Validate() error = %v, want ValidationErrors", err)
+	}
+	fields := map[string]bool{}
+	for _, e := range *ve {
+		fields[e.Field] = true
+	}
+	for _, field := range []string{"email", "password"} {
+		if !fields[field] {
+			t.Errorf("Validate() missing error for %q: %v", field, *ve)
+		}
+	}
+}
+
+func TestRegistration_Validate_ShortPassword(t *testing.T) {
+	registration := validRegistration()
+	registration.Password = "curta"
+
+	var ve *apperrors.ValidationErrors
+	if err := registration.Validate(); !errors.As(err, &ve) || (*ve)[0].Field != "password" {
+		t.Fatalf("Validate() error = %v, want password error", err)
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	if got := NormalizeEmail("  Doutor@Fut.App "); got != "doutor@fut.app" {
+		t.Errorf("NormalizeEmail() = %q", got)
+	}
+}
+
+func TestPrincipalFromContext(t *testing.T) {
+	if _, ok := PrincipalFromContext(context.Background()); ok {
+		t.Fatal("PrincipalFromContext() ok = true on an anonymous context")
+	}
+
+	claims := TokenClaims{Principal: Principal{AccountID: 1, Role: RolePlayer}, ID: "jti"}
+	got, ok := PrincipalFromContext(WithPrincipal(context.Background(), claims))
+	if !ok || got != claims {
+		t.Errorf("PrincipalFromContext() = %+v, %v, want %+v", got, ok, claims)
+	}
+}
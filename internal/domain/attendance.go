@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"fut-app/internal/errors"
+)
+
+type AttendanceStatus string
+
+const (
+	AttendanceGoing      AttendanceStatus = "going"
+	AttendanceWaitlisted AttendanceStatus = "waitlisted"
+	AttendanceDeclined   AttendanceStatus = "declined"
+)
+
+type (
+	// Attendance é a resposta do jogador à convocação da partida. RespondedAt é quando ele
+	// entrou na lista ou saiu dela, e ordena a fila de espera.
+	Attendance struct {
+		MatchID     uint
+		PlayerID    uint
+		Status      AttendanceStatus
+		RespondedAt time.Time
+		NoShow      bool
+	}
+
+	// RSVP confirma (Going) ou recusa a presença do jogador na partida.
+	RSVP struct {
+		MatchID  uint
+		PlayerID uint
+		Going    bool
+	}
+
+	// Roster é a lista de presença da partida: confirmados até MaxPlayers e, passado o
+	// limite, fila de espera por ordem de resposta. MaxPlayers zero não limita.
+	Roster struct {
+		MatchID     uint
+		MaxPlayers  int
+		Attendances []Attendance
+	}
+
+	// Reliability resume a presença do jogador nas partidas encerradas em que confirmou.
+	Reliability struct {
+		Confirmed int
+		NoShows   int
+		// Rate é a porcentagem das partidas confirmadas em que o jogador apareceu; sem
+		// nenhuma confirmação, vale 100.
+		Rate int
+	}
+)
+
+// Going lista os confirmados na ordem da lista.
+func (r Roster) Going() []uint {
+	ids := []uint{}
+	for _, a := range r.Attendances {
+		if a.Status == AttendanceGoing {
+			ids = append(ids, a.PlayerID)
+		}
+	}
+	return ids
+}
+
+func (r Roster) full() bool {
+	return r.MaxPlayers > 0 && len(r.Going()) >= r.MaxPlayers
+}
+
+// Respond aplica a resposta do jogador e devolve as presenças alteradas, inclusive a de
+// quem saiu da fila de espera. Confirmar de novo não muda nada: o jogador mantém o lugar
+// que já tinha, na lista ou na fila.
+func (r *Roster) Respond(playerID uint, going bool, now time.Time) []Attendance {
+	i := r.index(playerID)
+	if i < 0 {
+		r.Attendances = append(r.Attendances, Attendance{MatchID: r.MatchID, PlayerID: playerID})
+		i = len(r.Attendances) - 1
+	}
+
+	previous := r.Attendances[i].Status
+	next := AttendanceDeclined
+	if going {
+		if previous == AttendanceGoing || previous == AttendanceWaitlisted {
+			return nil
+		}
+		next = AttendanceGoing
+		if r.full() {
+			next = AttendanceWaitlisted
+		}
+	} else if previous == AttendanceDeclined {
+		return nil
+	}
+
+	r.Attendances[i].Status = next
+	r.Attendances[i].RespondedAt = now
+	changed := []Attendance{r.Attendances[i]}
+	if previous == AttendanceGoing {
+		changed = append(changed, r.Promote()...)
+	}
+	return changed
+}
+
+// Promote passa os primeiros da fila de espera para a lista enquanto houver vaga e devolve
+// os promovidos.
+func (r *Roster) Promote() []Attendance {
+	var promoted []Attendance
+	for !r.full() {
+		next := -1
+		for i, a := range r.Attendances {
+			if a.Status == AttendanceWaitlisted && (next < 0 || a.RespondedAt.Before(r.Attendances[next].RespondedAt)) {
+				next = i
+			}
+		}
+		if next < 0 {
+			break
+		}
+		r.Attendances[next].Status = AttendanceGoing
+		promoted = append(promoted, r.Attendances[next])
+	}
+	return promoted
+}
+
+// MarkNoShows marca como ausentes os confirmados listados e desmarca os demais, para que o
+// organizador corrija a lista reenviando-a. Devolve as presenças alteradas.
+func (r *Roster) MarkNoShows(playerIDs []uint) ([]Attendance, error) {
+	var errs errors.ValidationErrors
+	absent := make(map[uint]bool, len(playerIDs))
+	for _, id := range playerIDs {
+		if i := r.index(id); i < 0 || r.Attendances[i].Status != AttendanceGoing {
+			errs.Append("player_ids", fmt.Sprintf("Player %d did not confirm attendance", id))
+		}
+		absent[id] = true
+	}
+	if errs.HasErrors() {
+		return nil, &errs
+	}
+
+	var changed []Attendance
+	for i, a := range r.Attendances {
+		if a.Status == AttendanceGoing && a.NoShow != absent[a.PlayerID] {
+			r.Attendances[i].NoShow = absent[a.PlayerID]
+			changed = append(changed, r.Attendances[i])
+		}
+	}
+	return changed, nil
+}
+
+func (r Roster) index(playerID uint) int {
+	for i, a := range r.Attendances {
+		if a.PlayerID == playerID {
+			return i
+		}
+	}
+	return -1
+}
+
+func NewReliability(confirmed, noShows int) Reliability {
+	rate := 100
+	if confirmed > 0 {
+		rate = (confirmed - noShows) * 100 / confirmed
+	}
+	return Reliability{Confirmed: confirmed, NoShows: noShows, Rate: rate}
+}
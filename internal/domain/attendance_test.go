@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	apperrors "fut-app/internal/errors"
+)
+
+var rsvpStart = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// respondAll confirma os jogadores um minuto depois do outro, na ordem dada.
+func respondAll(r *Roster, ids ...uint) {
+	for i, id := range ids {
+		r.Respond(id, true, rsvpStart.Add(time.Duration(i)*time.Minute))
+	}
+}
+
+func statusOf(r Roster, playerID uint) AttendanceStatus {
+	if i := r.index(playerID); i >= 0 {
+		return r.Attendances[i].Status
+	}
+	return ""
+}
+
+func TestRoster_Respond_WaitlistWhenFull(t *testing.T) {
+	r := Roster{MatchID: 1, MaxPlayers: 2}
+	respondAll(&r, 1, 2, 3)
+
+	if got := r.Going(); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Going() = %v, want [1 2]", got)
+	}
+	if statusOf(r, 3) != AttendanceWaitlisted {
+		t.Errorf("player 3 status = %v, want waitlisted", statusOf(r, 3))
+	}
+}
+
+func TestRoster_Respond_PromotesFIFOWhenSomeoneDrops(t *testing.T) {
+	r := Roster{MatchID: 1, MaxPlayers: 2}
+	respondAll(&r, 1, 2, 3, 4)
+
+	changed := r.Respond(1, false, rsvpStart.Add(time.Hour))
+	if len(changed) != 2 || changed[0].Status != AttendanceDeclined || changed[1].PlayerID != 3 || changed[1].Status != AttendanceGoing {
+		t.Fatalf("Respond() changed = %+v, want decline of 1 and promotion of 3", changed)
+	}
+	if statusOf(r, 4) != AttendanceWaitlisted {
+		t.Errorf("player 4 status = %v, want still waitlisted", statusOf(r, 4))
+	}
+
+	// Quem volta depois de recusar entra no fim da fila.
+	r.Respond(1, true, rsvpStart.Add(2*time.Hour))
+	r.Respond(2, false, rsvpStart.Add(3*time.Hour))
+	if statusOf(r, 4) != AttendanceGoing || statusOf(r, 1) != AttendanceWaitlisted {
+		t.Errorf("statuses = 1:%v 4:%v, want 4 promoted before 1", statusOf(r, 1), statusOf(r, 4))
+	}
+}
+
+func TestRoster_Respond_RepeatedAnswerKeepsPlace(t *testing.T) {
+	r := Roster{MatchID: 1, MaxPlayers: 1}
+	respondAll(&r, 1, 2)
+
+	if changed := r.Respond(2, true, rsvpStart.Add(time.Hour)); changed != nil {
+		t.Errorf("Respond() again = %+v, want no change", changed)
+	}
+	if changed := r.Respond(3, false, rsvpStart); len(changed) != 1 || changed[0].Status != AttendanceDeclined {
+		t.Errorf("Respond() first decline = %+v, want declined", changed)
+	}
+	if changed := r.Respond(3, false, rsvpStart); changed != nil {
+		t.Errorf("Respond() decline again = %+v, want no change", changed)
+	}
+}
+
+func TestRoster_Promote_AfterRaisingLimit(t *testing.T) {
+	r := Roster{MatchID: 1, MaxPlayers: 1}
+	respondAll(&r, 1, 2, 3)
+
+	r.MaxPlayers = 0
+	if promoted := r.Promote(); len(promoted) != 2 {
+		t.Errorf("Promote() = %+v, want both waitlisted players", promoted)
+	}
+}
+
+func TestRoster_MarkNoShows(t *testing.T) {
+	r := Roster{MatchID: 1}
+	respondAll(&r, 1, 2)
+	r.Respond(3, false, rsvpStart)
+
+	changed, err := r.MarkNoShows([]uint{2})
+	if err != nil || len(changed) != 1 || !changed[0].NoShow {
+		t.Fatalf("MarkNoShows() = %+v, %v", changed, err)
+	}
+	// Reenviar a lista corrige a marcação anterior.
+	if changed, _ := r.MarkNoShows([]uint{1}); len(changed) != 2 {
+		t.Errorf("MarkNoShows() correction changed = %+v, want 2 entries", changed)
+	}
+
+	var ve *apperrors.ValidationErrors
+	if _, err := r.MarkNoShows([]uint{3, 9}); !errors.As(err, &ve) || len(*ve) != 2 {
+		t.Errorf("MarkNoShows() unconfirmed error = %v, want one error per player", err)
+	}
+}
+
+func TestNewReliability(t *testing.T) {
+	if got := NewReliability(0, 0); got.Rate != 100 {
+		t.Errorf("NewReliability(0, 0) rate = %d, want 100", got.Rate)
+	}
+	if got := NewReliability(8, 2); got.Rate != 75 || got.Confirmed != 8 || got.NoShows != 2 {
+		t.Errorf("NewReliability(8, 2) = %+v, want rate 75", got)
+	}
+}
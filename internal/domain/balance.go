@@ -0,0 +1,434 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"fut-app/internal/errors"
+)
+
+const (
+	MinTeams = 2
+
+	missingGoalkeeperPenalty = 1000.0
+	lineSpreadPenalty        = 2.0
+	maxBalanceIterations     = 500
+)
+
+type (
+	// DrawRequest descreve o sorteio de times de uma partida. Sem PlayerIDs, o sorteio
+	// usa os jogadores já escalados na partida.
+	DrawRequest struct {
+		MatchID      uint
+		PlayerIDs    []uint
+		Teams        int
+		KeepApart    [][]uint
+		KeepTogether [][]uint
+	}
+
+	// DrawnPlayer é o jogador no sorteio; Line é o setor da posição principal e
+	// Goalkeeper indica se alguma das posições é de goleiro.
+	DrawnPlayer struct {
+		ID         uint
+		Name       string
+		Positions  []string
+		Line       Line
+		Goalkeeper bool
+		Overall    int
+	}
+
+	DrawnTeam struct {
+		Name           string
+		Players        []DrawnPlayer
+		TotalOverall   int
+		AverageOverall float64
+		// Delta é a diferença entre a média do time e a média geral do sorteio.
+		Delta float64
+	}
+
+	TeamDraw struct {
+		MatchID  uint
+		Teams    []DrawnTeam
+		MaxDelta float64
+	}
+)
+
+// Validate confere a quantidade de times e as restrições antes de buscar os jogadores.
+func (r DrawRequest) Validate() error {
+	var errs errors.ValidationErrors
+
+	if r.Teams < MinTeams {
+		errs.Append("teams", fmt.Sprintf("At least %d teams are required", MinTeams))
+	} else if len(r.PlayerIDs) < 2*r.Teams {
+		errs.Append("player_ids", fmt.Sprintf("At least %d players are required for %d teams", 2*r.Teams, r.Teams))
+	}
+
+	confirmed := make(map[uint]bool, len(r.PlayerIDs))
+	for _, id := range r.PlayerIDs {
+		if confirmed[id] {
+			errs.Append("player_ids", fmt.Sprintf("Player %d is listed more than once", id))
+		}
+		confirmed[id] = true
+	}
+
+	checkGroups := func(field string, groups [][]uint) {
+		for i, group := range groups {
+			if len(group) < 2 {
+				errs.Append(fmt.Sprintf("%s[%d]", field, i), "A constraint needs at least two players")
+			}
+			for _, id := range group {
+				if !confirmed[id] {
+					errs.Append(fmt.Sprintf("%s[%d]", field, i), fmt.Sprintf("Player %d is not in the draw", id))
+				}
+			}
+		}
+	}
+	checkGroups("keep_apart", r.KeepApart)
+	checkGroups("keep_together", r.KeepTogether)
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+// unit é um grupo de jogadores que precisa ficar no mesmo time (um jogador sozinho é uma unit).
+type unit struct {
+	players []DrawnPlayer
+	total   int
+	keepers int
+	lines   map[Line]int
+}
+
+type balancer struct {
+	units      []*unit
+	apart      map[uint]map[uint]bool
+	teams      [][]*unit
+	minSize    int
+	maxSize    int
+	keepers    int
+	idealLines map[Line]float64
+}
+
+// BalanceTeams divide os jogadores em times de tamanhos iguais (±1) minimizando a
+// diferença de overall médio, com um goleiro por time quando houver goleiros
+// suficientes, distribuição de setores parecida e respeitando as restrições.
+func BalanceTeams(players []DrawnPlayer, req DrawRequest, names []string) (*TeamDraw, error) {
+	b, err := newBalancer(players, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.seed(); err != nil {
+		return nil, err
+	}
+	b.improve()
+	return b.result(req.MatchID, names), nil
+}
+
+func newBalancer(players []DrawnPlayer, req DrawRequest) (*balancer, error) {
+	var errs errors.ValidationErrors
+
+	parent := make(map[uint]uint, len(players))
+	for _, p := range players {
+		parent[p.ID] = p.ID
+	}
+	var find func(uint) uint
+	find = func(id uint) uint {
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+	for _, group := range req.KeepTogether {
+		for _, id := range group[1:] {
+			parent[find(id)] = find(group[0])
+		}
+	}
+
+	apart := make(map[uint]map[uint]bool)
+	for i, group := range req.KeepApart {
+		for _, a := range group {
+			for _, c := range group {
+				if a == c {
+					continue
+				}
+				if find(a) == find(c) {
+					errs.Append(fmt.Sprintf("keep_apart[%d]", i), fmt.Sprintf("Players %d and %d must also be kept together", a, c))
+					continue
+				}
+				if apart[a] == nil {
+					apart[a] = make(map[uint]bool)
+				}
+				apart[a][c] = true
+			}
+		}
+	}
+
+	b := &balancer{
+		apart:      apart,
+		teams:      make([][]*unit, req.Teams),
+		minSize:    len(players) / req.Teams,
+		maxSize:    (len(players) + req.Teams - 1) / req.Teams,
+		idealLines: make(map[Line]float64),
+	}
+
+	byRoot := make(map[uint]*unit)
+	for _, p := range players {
+		root := find(p.ID)
+		u, ok := byRoot[root]
+		if !ok {
+			u = &unit{lines: make(map[Line]int)}
+			byRoot[root] = u
+			b.units = append(b.units, u)
+		}
+		u.players = append(u.players, p)
+		u.total += p.Overall
+		if p.Goalkeeper {
+			u.keepers++
+			b.keepers++
+		}
+		u.lines[p.Line]++
+		b.idealLines[p.Line] += 1 / float64(req.Teams)
+	}
+
+	for _, u := range b.units {
+		if len(u.players) > b.maxSize {
+			errs.Append("keep_together", fmt.Sprintf("Group of %d players does not fit in a team of %d", len(u.players), b.maxSize))
+		}
+	}
+
+	if errs.HasErrors() {
+		return nil, &errs
+	}
+	return b, nil
+}
+
+// NewDrawnPlayer monta o jogador do sorteio resolvendo os setores das posições pelo catálogo.
+func NewDrawnPlayer(id uint, name string, positions []string, overall int, catalog PositionCatalog) DrawnPlayer {
+	p := DrawnPlayer{ID: id, Name: name, Positions: positions, Overall: overall}
+	for i, pos := range positions {
+		line := catalog.LineOf(pos)
+		if i == 0 {
+			p.Line = line
+		}
+		if line == LineGoal {
+			p.Goalkeeper = true
+		}
+	}
+	return p
+}
+
+// seed faz uma distribuição inicial gulosa: goleiros primeiro, depois as units mais
+// fortes sempre para o time mais fraco que ainda tem vaga e não tem conflito.
+func (b *balancer) seed() error {
+	sort.SliceStable(b.units, func(i, j int) bool {
+		if (b.units[i].keepers > 0) != (b.units[j].keepers > 0) {
+			return b.units[i].keepers > 0
+		}
+		if len(b.units[i].players) != len(b.units[j].players) {
+			return len(b.units[i].players) > len(b.units[j].players)
+		}
+		return b.units[i].total > b.units[j].total
+	})
+
+	for _, u := range b.units {
+		best := -1
+		for t := range b.teams {
+			if b.size(t)+len(u.players) > b.maxSize || b.conflicts(t, u, nil) {
+				continue
+			}
+			if best == -1 || b.seedScore(t, u) < b.seedScore(best, u) {
+				best = t
+			}
+		}
+		if best == -1 {
+			var errs errors.ValidationErrors
+			errs.Append("keep_apart", fmt.Sprintf("Players cannot be split into %d teams with these constraints", len(b.teams)))
+			return &errs
+		}
+		b.teams[best] = append(b.teams[best], u)
+	}
+	return nil
+}
+
+func (b *balancer) seedScore(t int, u *unit) float64 {
+	score := float64(b.total(t))
+	if u.keepers > 0 && b.keepersIn(t) > 0 {
+		score += missingGoalkeeperPenalty
+	}
+	return score
+}
+
+// improve faz busca local: troca units de mesmo tamanho entre times, ou move units
+// entre times, enquanto o custo diminuir.
+func (b *balancer) improve() {
+	cost := b.cost()
+	for iter := 0; iter < maxBalanceIterations; iter++ {
+		improved := false
+		for t1 := 0; t1 < len(b.teams) && !improved; t1++ {
+			for t2 := t1 + 1; t2 < len(b.teams) && !improved; t2++ {
+				improved = b.trySwaps(t1, t2, &cost) || b.tryMoves(t1, t2, &cost) || b.tryMoves(t2, t1, &cost)
+			}
+		}
+		if !improved {
+			return
+		}
+	}
+}
+
+func (b *balancer) trySwaps(t1, t2 int, cost *float64) bool {
+	for i, u1 := range b.teams[t1] {
+		for j, u2 := range b.teams[t2] {
+			if len(u1.players) != len(u2.players) {
+				continue
+			}
+			if b.conflicts(t2, u1, u2) || b.conflicts(t1, u2, u1) {
+				continue
+			}
+			b.teams[t1][i], b.teams[t2][j] = u2, u1
+			if c := b.cost(); c < *cost-1e-9 {
+				*cost = c
+				return true
+			}
+			b.teams[t1][i], b.teams[t2][j] = u1, u2
+		}
+	}
+	return false
+}
+
+func (b *balancer) tryMoves(from, to int, cost *float64) bool {
+	for i, u := range b.teams[from] {
+		if b.size(from)-len(u.players) < b.minSize || b.size(to)+len(u.players) > b.maxSize {
+			continue
+		}
+		if b.conflicts(to, u, nil) {
+			continue
+		}
+		original := b.teams[from]
+		b.teams[from] = append(append([]*unit{}, original[:i]...), original[i+1:]...)
+		b.teams[to] = append(b.teams[to], u)
+		if c := b.cost(); c < *cost-1e-9 {
+			*cost = c
+			return true
+		}
+		b.teams[to] = b.teams[to][:len(b.teams[to])-1]
+		b.teams[from] = original
+	}
+	return false
+}
+
+// cost combina a diferença entre a maior e a menor média de overall com penalidades
+// por time sem goleiro e por setores desequilibrados.
+func (b *balancer) cost() float64 {
+	minAvg, maxAvg := math.Inf(1), math.Inf(-1)
+	penalty := 0.0
+	for t := range b.teams {
+		avg := b.average(t)
+		minAvg = math.Min(minAvg, avg)
+		maxAvg = math.Max(maxAvg, avg)
+
+		if b.keepers >= len(b.teams) && b.keepersIn(t) == 0 {
+			penalty += missingGoalkeeperPenalty
+		}
+		lines := b.lines(t)
+		for line, ideal := range b.idealLines {
+			penalty += lineSpreadPenalty * math.Abs(float64(lines[line])-ideal)
+		}
+	}
+	return maxAvg - minAvg + penalty
+}
+
+// conflicts informa se colocar u no time t (opcionalmente retirando without) junta
+// jogadores que precisam ficar separados.
+func (b *balancer) conflicts(t int, u, without *unit) bool {
+	for _, other := range b.teams[t] {
+		if other == without || other == u {
+			continue
+		}
+		for _, p := range u.players {
+			for _, q := range other.players {
+				if b.apart[p.ID][q.ID] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (b *balancer) size(t int) int {
+	n := 0
+	for _, u := range b.teams[t] {
+		n += len(u.players)
+	}
+	return n
+}
+
+func (b *balancer) total(t int) int {
+	total := 0
+	for _, u := range b.teams[t] {
+		total += u.total
+	}
+	return total
+}
+
+func (b *balancer) average(t int) float64 {
+	if n := b.size(t); n > 0 {
+		return float64(b.total(t)) / float64(n)
+	}
+	return 0
+}
+
+func (b *balancer) keepersIn(t int) int {
+	n := 0
+	for _, u := range b.teams[t] {
+		n += u.keepers
+	}
+	return n
+}
+
+func (b *balancer) lines(t int) map[Line]int {
+	lines := make(map[Line]int)
+	for _, u := range b.teams[t] {
+		for line, n := range u.lines {
+			lines[line] += n
+		}
+	}
+	return lines
+}
+
+func (b *balancer) result(matchID uint, names []string) *TeamDraw {
+	draw := &TeamDraw{MatchID: matchID, Teams: make([]DrawnTeam, len(b.teams))}
+
+	var sumAvg float64
+	for t := range b.teams {
+		team := DrawnTeam{Name: teamName(names, t)}
+		for _, u := range b.teams[t] {
+			team.Players = append(team.Players, u.players...)
+		}
+		sort.Slice(team.Players, func(i, j int) bool { return team.Players[i].Overall > team.Players[j].Overall })
+		team.TotalOverall = b.total(t)
+		team.AverageOverall = round2(b.average(t))
+		sumAvg += b.average(t)
+		draw.Teams[t] = team
+	}
+
+	mean := sumAvg / float64(len(b.teams))
+	for t := range draw.Teams {
+		draw.Teams[t].Delta = round2(b.average(t) - mean)
+		draw.MaxDelta = math.Max(draw.MaxDelta, math.Abs(draw.Teams[t].Delta))
+	}
+	return draw
+}
+
+func teamName(names []string, t int) string {
+	if t < len(names) && names[t] != "" {
+		return names[t]
+	}
+	return fmt.Sprintf("Time %d", t+1)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func drawPlayers() []DrawnPlayer {
+	players := []DrawnPlayer{
+		{ID: 1, Name: "Taffarel", Positions: []string{"GK"}, Overall: 80},
+		{ID: 2, Name: "Dida", Positions: []string{"GK"}, Overall: 70},
+		{ID: 3, Name: "Aldair", Positions: []string{"CB"}, Overall: 85},
+		{ID: 4, Name: "Lúcio", Positions: []string{"CB"}, Overall: 82},
+		{ID: 5, Name: "Cafu", Positions: []string{"RB"}, Overall: 88},
+		{ID: 6, Name: "Dunga", Positions: []string{"CDM"}, Overall: 84},
+		{ID: 7, Name: "Rivaldo", Positions: []string{"CAM"}, Overall: 92},
+		{ID: 8, Name: "Kaká", Positions: []string{"CAM"}, Overall: 90},
+		{ID: 9, Name: "Ronaldo", Positions: []string{"ST"}, Overall: 95},
+		{ID: 10, Name: "Romário", Positions: []string{"ST"}, Overall: 93},
+		{ID: 11, Name: "Bebeto", Positions: []string{"LW"}, Overall: 86},
+		{ID: 12, Name: "Edmundo", Positions: []string{"RW"}, Overall: 78},
+	}
+	for i, p := range players {
+		players[i] = NewDrawnPlayer(p.ID, p.Name, p.Positions, p.Overall, nil)
+	}
+	return players
+}
+
+func TestNewDrawnPlayer_UsesCatalog(t *testing.T) {
+	catalog := NewPositionCatalog([]Position{{Name: "Líbero", Abbreviation: "LIB", Line: LineDefense}})
+
+	p := NewDrawnPlayer(1, "Mauro Galvão", []string{"lib", "Goleiro"}, 80, catalog)
+
+	if p.Line != LineDefense || !p.Goalkeeper {
+		t.Errorf("NewDrawnPlayer() = %+v, want defense line and goalkeeper", p)
+	}
+}
+
+func drawIDs(players []DrawnPlayer) []uint {
+	ids := make([]uint, len(players))
+	for i, p := range players {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func teamOf(draw *TeamDraw, id uint) int {
+	for t, team := range draw.Teams {
+		for _, p := range team.Players {
+			if p.ID == id {
+				return t
+			}
+		}
+	}
+	return -1
+}
+
+func TestBalanceTeams_BalancedWithGoalkeepers(t *testing.T) {
+	players := drawPlayers()
+	req := DrawRequest{MatchID: 1, PlayerIDs: drawIDs(players), Teams: 2}
+
+	draw, err := BalanceTeams(players, req, []string{"Colete", "Sem colete"})
+	if err != nil {
+		t.Fatalf("BalanceTeams() error = %v", err)
+	}
+
+	if len(draw.Teams) != 2 || draw.Teams[0].Name != "Colete" {
+		t.Fatalf("BalanceTeams() teams = %+v", draw.Teams)
+	}
+	for _, team := range draw.Teams {
+		if len(team.Players) != 6 {
+			t.Errorf("team %s has %d players, want 6", team.Name, len(team.Players))
+		}
+	}
+	if teamOf(draw, 1) == teamOf(draw, 2) {
+		t.Error("each team should get one goalkeeper")
+	}
+	if draw.MaxDelta > 1.5 {
+		t.Errorf("BalanceTeams() max delta = %v, want <= 1.5", draw.MaxDelta)
+	}
+	if math.Abs(draw.Teams[0].Delta+draw.Teams[1].Delta) > 0.011 {
+		t.Errorf("deltas should mirror each other: %v / %v", draw.Teams[0].Delta, draw.Teams[1].Delta)
+	}
+}
+
+func TestBalanceTeams_Constraints(t *testing.T) {
+	players := drawPlayers()
+	req := DrawRequest{
+		PlayerIDs:    drawIDs(players),
+		Teams:        2,
+		KeepApart:    [][]uint{{9, 10}},
+		KeepTogether: [][]uint{{7, 11}},
+	}
+
+	draw, err := BalanceTeams(players, req, nil)
+	if err != nil {
+		t.Fatalf("BalanceTeams() error = %v", err)
+	}
+	if teamOf(draw, 9) == teamOf(draw, 10) {
+		t.Error("players 9 and 10 should be apart")
+	}
+	if teamOf(draw, 7) != teamOf(draw, 11) {
+		t.Error("players 7 and 11 should be together")
+	}
+	if draw.Teams[1].Name != "Time 2" {
+		t.Errorf("default team name = %q, want Time 2", draw.Teams[1].Name)
+	}
+}
+
+func TestBalanceTeams_ThreeTeamsUnevenSizes(t *testing.T) {
+	players := drawPlayers()[:11]
+	req := DrawRequest{PlayerIDs: drawIDs(players), Teams: 3}
+
+	draw, err := BalanceTeams(players, req, nil)
+	if err != nil {
+		t.Fatalf("BalanceTeams() error = %v", err)
+	}
+	total := 0
+	for _, team := range draw.Teams {
+		if n := len(team.Players); n < 3 || n > 4 {
+			t.Errorf("team %s has %d players, want 3 or 4", team.Name, n)
+		}
+		total += len(team.Players)
+	}
+	if total != 11 {
+		t.Errorf("drawn players = %d, want 11", total)
+	}
+}
+
+func TestBalanceTeams_ContradictoryConstraints(t *testing.T) {
+	players := drawPlayers()
+	req := DrawRequest{
+		PlayerIDs:    drawIDs(players),
+		Teams:        2,
+		KeepApart:    [][]uint{{3, 5}},
+		KeepTogether: [][]uint{{3, 4}, {4, 5}},
+	}
+
+	fields := validationFields(t, func() error { _, err := BalanceTeams(players, req, nil); return err }())
+	if fields[0] != "keep_apart[0]" {
+		t.Errorf("BalanceTeams() fields = %v, want keep_apart[0]", fields)
+	}
+}
+
+func TestDrawRequest_Validate(t *testing.T) {
+	req := DrawRequest{
+		PlayerIDs: []uint{1, 2, 3, 3},
+		Teams:     2,
+		KeepApart: [][]uint{{1, 99}},
+	}
+
+	fields := validationFields(t, req.Validate())
+	want := []string{"player_ids", "keep_apart[0]"}
+	if len(fields) != len(want) || fields[0] != want[0] || fields[1] != want[1] {
+		t.Errorf("Validate() fields = %v, want %v", fields, want)
+	}
+}
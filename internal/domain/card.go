@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+type (
+	// PlayerCard é a carta estilo FIFA do jogador, derivada das notas recebidas.
+	// Reliability vem da lista de presença, não das notas, e fica de fora de Card.
+	PlayerCard struct {
+		PlayerID     uint
+		Name         string
+		Positions    []string
+		Stats        Stats
+		Overall      int
+		RatingsCount int
+		Reliability  Reliability
+	}
+
+	// RatingEngine calcula a carta a partir das notas: notas recentes pesam mais
+	// (decaimento exponencial com meia-vida HalfLife) e, por atributo, as notas
+	// mais extremas são descartadas na proporção TrimFraction de cada ponta.
+	RatingEngine struct {
+		HalfLife     time.Duration
+		TrimFraction float64
+		Now          func() time.Time
+	}
+)
+
+// lineWeights define, em pontos percentuais, quanto cada atributo pesa no overall de cada setor.
+var lineWeights = map[Line]Stats{
+	LineGoal:     {Finishing: 5, Passing: 20, Speed: 10, Defense: 35, Stamina: 15, Highlight: 15},
+	LineDefense:  {Finishing: 5, Passing: 15, Speed: 15, Defense: 35, Stamina: 15, Highlight: 15},
+	LineMidfield: {Finishing: 15, Passing: 30, Speed: 10, Defense: 15, Stamina: 15, Highlight: 15},
+	LineAttack:   {Finishing: 35, Passing: 15, Speed: 20, Defense: 5, Stamina: 10, Highlight: 15},
+}
+
+var evenWeights = Stats{Finishing: 17, Passing: 17, Speed: 17, Defense: 17, Stamina: 16, Highlight: 16}
+
+func NewRatingEngine() RatingEngine {
+	return RatingEngine{
+		HalfLife:     90 * 24 * time.Hour,
+		TrimFraction: 0.1,
+		Now:          time.Now,
+	}
+}
+
+// Card monta a carta do jogador. Sem notas recebidas, usa os atributos já gravados em Stats.
+func (e RatingEngine) Card(player Player, ratings []Rating) PlayerCard {
+	card := PlayerCard{
+		PlayerID:     player.ID,
+		Name:         player.Name,
+		Positions:    player.Position,
+		RatingsCount: len(ratings),
+	}
+
+	if len(ratings) == 0 {
+		card.Stats = player.Stats
+	} else {
+		card.Stats = e.aggregate(ratings)
+	}
+	card.Overall = card.Stats.Overall(primaryLine(player.Position))
+	return card
+}
+
+func primaryLine(positions []string) Line {
+	if len(positions) == 0 {
+		return ""
+	}
+	return LineOf(positions[0])
+}
+
+type weightedValue struct {
+	value  float64
+	weight float64
+}
+
+func (e RatingEngine) aggregate(ratings []Rating) Stats {
+	now := e.Now()
+	weights := make([]float64, len(ratings))
+	for i, r := range ratings {
+		age := now.Sub(r.CreatedAt)
+		if age < 0 || e.HalfLife <= 0 {
+			age = 0
+		}
+		weights[i] = math.Pow(0.5, float64(age)/float64(e.HalfLife))
+	}
+
+	attribute := func(pick func(Rating) int) int {
+		values := make([]weightedValue, len(ratings))
+		for i, r := range ratings {
+			values[i] = weightedValue{value: float64(pick(r)), weight: weights[i]}
+		}
+		return e.trimmedMean(values)
+	}
+
+	return Stats{
+		Finishing: attribute(func(r Rating) int { return r.Finishing }),
+		Passing:   attribute(func(r Rating) int { return r.Passing }),
+		Speed:     attribute(func(r Rating) int { return r.Speed }),
+		Defense:   attribute(func(r Rating) int { return r.Defense }),
+		Stamina:   attribute(func(r Rating) int { return r.Stamina }),
+		Highlight: attribute(func(r Rating) int { return r.Highlight }),
+	}
+}
+
+// trimmedMean descarta as notas extremas de cada ponta e tira a média ponderada do restante.
+func (e RatingEngine) trimmedMean(values []weightedValue) int {
+	sort.Slice(values, func(i, j int) bool { return values[i].value < values[j].value })
+
+	trim := int(float64(len(values)) * e.TrimFraction)
+	if len(values)-2*trim < 1 {
+		trim = 0
+	}
+	kept := values[trim : len(values)-trim]
+
+	var sum, totalWeight float64
+	for _, v := range kept {
+		sum += v.value * v.weight
+		totalWeight += v.weight
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return int(math.Round(sum / totalWeight))
+}
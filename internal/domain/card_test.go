@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+var cardNow = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func testEngine() RatingEngine {
+	engine := NewRatingEngine()
+	engine.Now = func() time.Time { return cardNow }
+	return engine
+}
+
+func uniformRating(value int, age time.Duration) Rating {
+	return Rating{
+		Finishing: value, Passing: value, Speed: value,
+		Defense: value, Stamina: value, Highlight: value,
+		CreatedAt: cardNow.Add(-age),
+	}
+}
+
+func TestRatingEngine_Card_AveragesRatings(t *testing.T) {
+	card := testEngine().Card(
+		Player{ID: 1, Name: "Zico", Position: []string{"CM"}},
+		[]Rating{uniformRating(70, 0), uniformRating(80, 0)},
+	)
+
+	if card.Stats.Finishing != 75 || card.Stats.Highlight != 75 {
+		t.Errorf("Card() attributes = %+v, want all 75", card.Stats)
+	}
+	if card.Overall != 75 {
+		t.Errorf("Card() overall = %d, want 75", card.Overall)
+	}
+	if card.RatingsCount != 2 || card.PlayerID != 1 {
+		t.Errorf("Card() = %+v", card)
+	}
+}
+
+func TestRatingEngine_Card_RecentRatingsWeighMore(t *testing.T) {
+	engine := testEngine()
+	card := engine.Card(
+		Player{Position: []string{"CM"}},
+		[]Rating{uniformRating(90, 0), uniformRating(60, engine.HalfLife)},
+	)
+
+	// Peso 1 para a nota recente e 0.5 para a antiga: (90 + 30) / 1.5 = 80.
+	if card.Stats.Passing != 80 {
+		t.Errorf("Card() passing = %d, want 80", card.Stats.Passing)
+	}
+}
+
+func TestRatingEngine_Card_TrimsOutliers(t *testing.T) {
+	ratings := []Rating{uniformRating(45, 0)}
+	for i := 0; i < 8; i++ {
+		ratings = append(ratings, uniformRating(80, 0))
+	}
+	ratings = append(ratings, uniformRating(99, 0))
+
+	card := testEngine().Card(Player{Position: []string{"CM"}}, ratings)
+
+	if card.Stats.Speed != 80 {
+		t.Errorf("Card() speed = %d, want 80 with outliers trimmed", card.Stats.Speed)
+	}
+}
+
+func TestRatingEngine_Card_FallsBackToStats(t *testing.T) {
+	player := Player{
+		Position: []string{"ST"},
+		Stats:    Stats{Finishing: 90, Passing: 70, Speed: 85, Defense: 50, Stamina: 75, Highlight: 80},
+	}
+
+	card := testEngine().Card(player, nil)
+
+	if card.RatingsCount != 0 || card.Stats.Speed != 85 || card.Stats.Finishing != 90 {
+		t.Errorf("Card() = %+v", card)
+	}
+}
+
+func TestStats_Overall_PositionWeighted(t *testing.T) {
+	attrs := Stats{Finishing: 50, Passing: 70, Speed: 70, Defense: 90, Stamina: 70, Highlight: 70}
+
+	defender := attrs.Overall(LineOf("CB"))
+	attacker := attrs.Overall(LineOf("ST"))
+	unknown := attrs.Overall(LineOf("Gandula"))
+
+	if defender <= attacker {
+		t.Errorf("Overall() defender = %d should be greater than attacker = %d", defender, attacker)
+	}
+	if defender != 76 {
+		t.Errorf("Overall() defender = %d, want 76", defender)
+	}
+	if unknown != 70 {
+		t.Errorf("Overall() unknown line = %d, want 70", unknown)
+	}
+}
+
+func TestLineOf(t *testing.T) {
+	tests := map[string]Line{
+		"GK": LineGoal, "Goleiro": LineGoal,
+		"cb": LineDefense, "Zagueiro": LineDefense,
+		"CAM": LineMidfield, "Meio-campo": LineMidfield,
+		"ST": LineAttack, " Atacante ": LineAttack,
+		"Técnico": "",
+	}
+	for position, want := range tests {
+		if got := LineOf(position); got != want {
+			t.Errorf("LineOf(%q) = %q, want %q", position, got, want)
+		}
+	}
+}
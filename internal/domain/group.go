@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"fut-app/internal/errors"
+)
+
+const (
+	MaxGroupNameLength = 100
+	JoinCodeLength     = 8
+	// joinCodeAlphabet deixa de fora 0/O e 1/I, que se confundem ao ditar o código.
+	joinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+type (
+	// Group é uma pelada. Jogadores, partidas e notas pertencem sempre a um grupo, e quem
+	// joga em dois grupos tem um jogador em cada um, com notas separadas.
+	Group struct {
+		ID        uint
+		Name      string
+		JoinCode  string
+		CreatedAt time.Time
+	}
+
+	// NewGroup cria o grupo junto com o jogador de quem o cria, que vira organizador.
+	NewGroup struct {
+		Name   string
+		Player PlayerProfile
+	}
+
+	// JoinGroup entra no grupo do código de convite criando o jogador da conta nele.
+	JoinGroup struct {
+		Code   string
+		Player PlayerProfile
+	}
+
+	// Membership liga a conta ao seu jogador dentro do grupo, com o papel dela ali.
+	Membership struct {
+		GroupID   uint
+		AccountID uint
+		PlayerID  uint
+		Role      Role
+	}
+
+	// GroupMember é a linha da listagem de membros do grupo.
+	GroupMember struct {
+		Membership
+		Name string
+	}
+)
+
+// GroupRoles são os papéis possíveis dentro de um grupo; admin só existe na conta.
+var GroupRoles = []Role{RoleOrganiser, RolePlayer}
+
+func (g NewGroup) Validate() error {
+	var errs errors.ValidationErrors
+
+	name := strings.TrimSpace(g.Name)
+	if name == "" {
+		errs.Append("name", "Name is required")
+	} else if len(name) > MaxGroupNameLength {
+		errs.Append("name", fmt.Sprintf("Name must have at most %d characters", MaxGroupNameLength))
+	}
+	appendPlayerErrors(g.Player.ToPlayer(), &errs)
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+func (j JoinGroup) Validate() error {
+	var errs errors.ValidationErrors
+
+	if j.Code == "" {
+		errs.Append("code", "Code is required")
+	}
+	appendPlayerErrors(j.Player.ToPlayer(), &errs)
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+// ValidateGroupRole aceita só os papéis de GroupRoles.
+func ValidateGroupRole(role Role) error {
+	for _, r := range GroupRoles {
+		if role == r {
+			return nil
+		}
+	}
+	var errs errors.ValidationErrors
+	errs.Append("role", fmt.Sprintf("Role must be one of %v", GroupRoles))
+	return &errs
+}
+
+// NormalizeJoinCode aceita o código digitado em minúsculas ou com espaços nas pontas.
+func NormalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// NewJoinCode sorteia um código de convite de JoinCodeLength caracteres.
+func NewJoinCode() (string, error) {
+	b := make([]byte, JoinCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = joinCodeAlphabet[int(b[i])%len(joinCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+func appendPlayerErrors(player Player, errs *errors.ValidationErrors) {
+	if err := player.Validate(); err != nil {
+		if ve, ok := err.(*errors.ValidationErrors); ok {
+			*errs = append(*errs, *ve...)
+		}
+	}
+}
+
+type membershipKey struct{}
+
+// WithMembership guarda em ctx o grupo da requisição e o vínculo da conta com ele.
+func WithMembership(ctx context.Context, m Membership) context.Context {
+	return context.WithValue(ctx, membershipKey{}, m)
+}
+
+// MembershipFromContext devolve o vínculo da requisição; ok é false fora das rotas de grupo.
+func MembershipFromContext(ctx context.Context) (Membership, bool) {
+	m, ok := ctx.Value(membershipKey{}).(Membership)
+	return m, ok
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	apperrors "fut-app/internal/errors"
+)
+
+func validGroupPlayer() PlayerProfile {
+	return PlayerProfile{Name: "Sócrates", Position: []string{"MC"}}
+}
+
+func TestNewGroup_Validate(t *testing.T) {
+	if err := (NewGroup{Name: "Pelada de quarta", Player: validGroupPlayer()}).Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+
+	player := validGroupPlayer()
+	player.Name = ""
+	err := NewGroup{Name: strings.Repeat("x", MaxGroupNameLength+1), Player: player}.Validate()
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) || len(*ve) != 2 {
+		t.Fatalf("Validate() error = %v, want name errors for group and player", err)
+	}
+}
+
+func TestJoinGroup_Validate(t *testing.T) {
+	var ve *apperrors.ValidationErrors
+	if err := (JoinGroup{Player: validGroupPlayer()}).Validate(); !errors.As(err, &ve) || (*ve)[0].Field != "code" {
+		t.Fatalf("Validate() error = %v, want code error", err)
+	}
+}
+
+func TestValidateGroupRole(t *testing.T) {
+	if err := ValidateGroupRole(RoleOrganiser); err != nil {
+		t.Errorf("ValidateGroupRole(organiser) error = %v, want nil", err)
+	}
+	var ve *apperrors.ValidationErrors
+	if err := ValidateGroupRole(RoleAdmin); !errors.As(err, &ve) {
+		t.Errorf("ValidateGroupRole(admin) error = %v, want ValidationErrors", err)
+	}
+}
+
+func TestNewJoinCode(t *testing.T) {
+	code, err := NewJoinCode()
+	if err != nil {
+		t.Fatalf("NewJoinCode() error = %v", err)
+	}
+	if len(code) != JoinCodeLength || strings.Trim(code, joinCodeAlphabet) != "" {
+		t.Errorf("NewJoinCode() = %q, want %d characters from the alphabet", code, JoinCodeLength)
+	}
+	if got := NormalizeJoinCode(" " + strings.ToLower(code) + " "); got != code {
+		t.Errorf("NormalizeJoinCode() = %q, want %q", got, code)
+	}
+}
@@ -0,0 +1,275 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"fut-app/internal/errors"
+)
+
+type MatchStatus string
+
+const (
+	MatchScheduled  MatchStatus = "scheduled"
+	MatchInProgress MatchStatus = "in_progress"
+	MatchFinished   MatchStatus = "finished"
+	MatchCancelled  MatchStatus = "cancelled"
+)
+
+// matchTransitions lista, para cada status, os próximos status permitidos.
+var matchTransitions = map[MatchStatus][]MatchStatus{
+	MatchScheduled:  {MatchInProgress, MatchCancelled},
+	MatchInProgress: {MatchFinished, MatchCancelled},
+	MatchFinished:   {},
+	MatchCancelled:  {},
+}
+
+type (
+	Team struct {
+		Name      string
+		PlayerIDs []uint
+		Score     *int
+	}
+
+	Match struct {
+		ID        uint
+		Venue     string
+		KickoffAt time.Time
+		Status    MatchStatus
+		HomeTeam  Team
+		AwayTeam  Team
+		// MaxPlayers limita os confirmados; zero não limita. RSVPDeadline nil aceita
+		// respostas até o início da partida.
+		MaxPlayers   int
+		RSVPDeadline *time.Time
+	}
+
+	// MatchPatch contém apenas os campos enviados em um PATCH; nil significa "não alterar".
+	MatchPatch struct {
+		Venue        *string
+		KickoffAt    *time.Time
+		Status       *MatchStatus
+		HomeTeam     *Team
+		AwayTeam     *Team
+		MaxPlayers   *int
+		RSVPDeadline *time.Time
+	}
+)
+
+func NewMatch(venue string, kickoffAt time.Time, home, away Team) *Match {
+	return &Match{
+		Venue:     venue,
+		KickoffAt: kickoffAt,
+		Status:    MatchScheduled,
+		HomeTeam:  home,
+		AwayTeam:  away,
+	}
+}
+
+func (s MatchStatus) IsValid() bool {
+	_, ok := matchTransitions[s]
+	return ok
+}
+
+func (s MatchStatus) CanTransitionTo(next MatchStatus) bool {
+	for _, allowed := range matchTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// RSVPClosesAt é o fim do prazo de resposta: o RSVPDeadline ou, sem ele, o início da partida.
+func (m Match) RSVPClosesAt() time.Time {
+	if m.RSVPDeadline != nil {
+		return *m.RSVPDeadline
+	}
+	return m.KickoffAt
+}
+
+// CheckRSVP aceita respostas só para partidas agendadas e dentro do prazo.
+func (m Match) CheckRSVP(now time.Time) error {
+	var errs errors.ValidationErrors
+	if m.Status != MatchScheduled {
+		errs.Append("match", "RSVPs are only accepted for scheduled matches")
+	} else if now.After(m.RSVPClosesAt()) {
+		errs.Append("rsvp_deadline", "The RSVP deadline has passed")
+	}
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+// HasPlayer informa se o jogador está escalado em algum dos dois times.
+func (m Match) HasPlayer(playerID uint) bool {
+	return m.TeamOf(playerID) != nil
+}
+
+// TeamOf retorna o time em que o jogador está escalado, ou nil.
+func (m *Match) TeamOf(playerID uint) *Team {
+	for _, team := range []*Team{&m.HomeTeam, &m.AwayTeam} {
+		for _, id := range team.PlayerIDs {
+			if id == playerID {
+				return team
+			}
+		}
+	}
+	return nil
+}
+
+func (m Match) Validate() error {
+	var errs errors.ValidationErrors
+
+	if m.Venue == "" {
+		errs.Append("venue", "Venue is required")
+	}
+	if m.KickoffAt.IsZero() {
+		errs.Append("kickoff_at", "Kickoff time is required")
+	}
+	if !m.Status.IsValid() {
+		errs.Append("status", fmt.Sprintf("Status '%s' is invalid", m.Status))
+	}
+	if m.MaxPlayers < 0 {
+		errs.Append("max_players", "Max players cannot be negative")
+	}
+	if m.RSVPDeadline != nil && !m.KickoffAt.IsZero() && m.RSVPDeadline.After(m.KickoffAt) {
+		errs.Append("rsvp_deadline", "RSVP deadline must not be after kickoff")
+	}
+	m.HomeTeam.validate("home_team", &errs)
+	m.AwayTeam.validate("away_team", &errs)
+
+	seen := make(map[uint]bool)
+	for _, id := range m.HomeTeam.PlayerIDs {
+		seen[id] = true
+	}
+	for _, id := range m.AwayTeam.PlayerIDs {
+		if seen[id] {
+			errs.Append("away_team.players", fmt.Sprintf("Player %d cannot play for both teams", id))
+		}
+	}
+
+	hasScore := m.HomeTeam.Score != nil || m.AwayTeam.Score != nil
+	switch m.Status {
+	case MatchScheduled, MatchCancelled:
+		if hasScore {
+			errs.Append("score", "Score can only be set once the match has started")
+		}
+	case MatchFinished:
+		if m.HomeTeam.Score == nil || m.AwayTeam.Score == nil {
+			errs.Append("score", "Final score is required to finish a match")
+		}
+	}
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+func (t Team) validate(field string, errs *errors.ValidationErrors) {
+	if t.Name == "" {
+		errs.Append(field+".name", "Team name is required")
+	}
+	seen := make(map[uint]bool, len(t.PlayerIDs))
+	for _, id := range t.PlayerIDs {
+		if id == 0 {
+			errs.Append(field+".players", "Player id is required")
+			continue
+		}
+		if seen[id] {
+			errs.Append(field+".players", fmt.Sprintf("Player %d is listed more than once", id))
+		}
+		seen[id] = true
+	}
+	if t.Score != nil && *t.Score < 0 {
+		errs.Append(field+".score", "Score cannot be negative")
+	}
+}
+
+// Apply aplica o patch respeitando as transições de status: escalação, local, horário e
+// regras de presença só mudam enquanto a partida está agendada.
+func (m *Match) Apply(p MatchPatch) error {
+	var errs errors.ValidationErrors
+
+	current := m.Status
+	if p.Status != nil && *p.Status != current {
+		if !p.Status.IsValid() {
+			errs.Append("status", fmt.Sprintf("Status '%s' is invalid", *p.Status))
+		} else if !current.CanTransitionTo(*p.Status) {
+			errs.Append("status", fmt.Sprintf("Cannot change status from '%s' to '%s'", current, *p.Status))
+		}
+	}
+
+	if current != MatchScheduled && (p.Venue != nil || p.KickoffAt != nil || p.MaxPlayers != nil || p.RSVPDeadline != nil ||
+		rosterChanged(m.HomeTeam, p.HomeTeam) || rosterChanged(m.AwayTeam, p.AwayTeam)) {
+		errs.Append("status", "Venue, kickoff time, rosters and RSVP rules can only change while the match is scheduled")
+	}
+
+	if errs.HasErrors() {
+		return &errs
+	}
+
+	if p.Venue != nil {
+		m.Venue = *p.Venue
+	}
+	if p.KickoffAt != nil {
+		m.KickoffAt = *p.KickoffAt
+	}
+	if p.MaxPlayers != nil {
+		m.MaxPlayers = *p.MaxPlayers
+	}
+	if p.RSVPDeadline != nil {
+		m.RSVPDeadline = p.RSVPDeadline
+	}
+	if p.HomeTeam != nil {
+		m.HomeTeam = mergeTeam(m.HomeTeam, *p.HomeTeam)
+	}
+	if p.AwayTeam != nil {
+		m.AwayTeam = mergeTeam(m.AwayTeam, *p.AwayTeam)
+	}
+	if p.Status != nil {
+		m.Status = *p.Status
+	}
+
+	return m.Validate()
+}
+
+func rosterChanged(current Team, patch *Team) bool {
+	if patch == nil {
+		return false
+	}
+	if patch.Name != "" && patch.Name != current.Name {
+		return true
+	}
+	if patch.PlayerIDs == nil {
+		return false
+	}
+	if len(patch.PlayerIDs) != len(current.PlayerIDs) {
+		return true
+	}
+	ids := make(map[uint]bool, len(current.PlayerIDs))
+	for _, id := range current.PlayerIDs {
+		ids[id] = true
+	}
+	for _, id := range patch.PlayerIDs {
+		if !ids[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeTeam(current, patch Team) Team {
+	if patch.Name != "" {
+		current.Name = patch.Name
+	}
+	if patch.PlayerIDs != nil {
+		current.PlayerIDs = patch.PlayerIDs
+	}
+	if patch.Score != nil {
+		current.Score = patch.Score
+	}
+	return current
+}
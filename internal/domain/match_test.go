@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"fut-app/internal/errors"
+)
+
+func intPtr(i int) *int { return &i }
+
+func newScheduledMatch() Match {
+	return *NewMatch(
+		"Society do Zé",
+		time.Date(2026, 10, 20, 20, 0, 0, 0, time.UTC),
+		Team{Name: "Colete", PlayerIDs: []uint{1, 2}},
+		Team{Name: "Sem colete", PlayerIDs: []uint{3, 4}},
+	)
+}
+
+func validationFields(t *testing.T, err error) []string {
+	t.Helper()
+	ve, ok := err.(*errors.ValidationErrors)
+	if !ok {
+		t.Fatalf("error type = %T, want *errors.ValidationErrors", err)
+	}
+	fields := make([]string, len(*ve))
+	for i, e := range *ve {
+		fields[i] = e.Field
+	}
+	return fields
+}
+
+func TestMatch_Validate_Success(t *testing.T) {
+	if err := newScheduledMatch().Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestMatch_Validate_RequiredFields(t *testing.T) {
+	match := Match{Status: MatchScheduled}
+
+	fields := validationFields(t, match.Validate())
+	want := []string{"venue", "kickoff_at", "home_team.name", "away_team.name"}
+	if len(fields) != len(want) {
+		t.Fatalf("Validate() fields = %v, want %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("Validate() field[%d] = %v, want %v", i, fields[i], want[i])
+		}
+	}
+}
+
+func TestMatch_Validate_PlayerInBothTeams(t *testing.T) {
+	match := newScheduledMatch()
+	match.AwayTeam.PlayerIDs = []uint{2, 5}
+
+	fields := validationFields(t, match.Validate())
+	if len(fields) != 1 || fields[0] != "away_team.players" {
+		t.Errorf("Validate() fields = %v, want [away_team.players]", fields)
+	}
+}
+
+func TestMatch_Validate_ScoreRules(t *testing.T) {
+	scheduled := newScheduledMatch()
+	scheduled.HomeTeam.Score = intPtr(1)
+	if fields := validationFields(t, scheduled.Validate()); fields[0] != "score" {
+		t.Errorf("Validate() scheduled with score fields = %v, want [score]", fields)
+	}
+
+	finished := newScheduledMatch()
+	finished.Status = MatchFinished
+	finished.HomeTeam.Score = intPtr(3)
+	if fields := validationFields(t, finished.Validate()); fields[0] != "score" {
+		t.Errorf("Validate() finished without away score fields = %v, want [score]", fields)
+	}
+}
+
+func TestMatchStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to MatchStatus
+		want     bool
+	}{
+		{MatchScheduled, MatchInProgress, true},
+		{MatchScheduled, MatchCancelled, true},
+		{MatchScheduled, MatchFinished, false},
+		{MatchInProgress, MatchFinished, true},
+		{MatchInProgress, MatchCancelled, true},
+		{MatchInProgress, MatchScheduled, false},
+		{MatchFinished, MatchInProgress, false},
+		{MatchCancelled, MatchScheduled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("CanTransitionTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatch_Apply_FullLifecycle(t *testing.T) {
+	match := newScheduledMatch()
+	inProgress, finished := MatchInProgress, MatchFinished
+
+	if err := match.Apply(MatchPatch{Status: &inProgress}); err != nil {
+		t.Fatalf("Apply(in_progress) error = %v", err)
+	}
+	err := match.Apply(MatchPatch{
+		Status:   &finished,
+		HomeTeam: &Team{Score: intPtr(5)},
+		AwayTeam: &Team{Score: intPtr(4)},
+	})
+	if err != nil {
+		t.Fatalf("Apply(finished) error = %v", err)
+	}
+	if match.Status != MatchFinished || *match.HomeTeam.Score != 5 || *match.AwayTeam.Score != 4 {
+		t.Errorf("Apply() match = %+v", match)
+	}
+	if len(match.HomeTeam.PlayerIDs) != 2 {
+		t.Errorf("Apply() should keep roster, got %v", match.HomeTeam.PlayerIDs)
+	}
+}
+
+func TestMatch_Apply_IllegalTransition(t *testing.T) {
+	match := newScheduledMatch()
+	finished := MatchFinished
+
+	fields := validationFields(t, match.Apply(MatchPatch{Status: &finished}))
+	if fields[0] != "status" {
+		t.Errorf("Apply() fields = %v, want [status]", fields)
+	}
+	if match.Status != MatchScheduled {
+		t.Errorf("Apply() status = %v, want unchanged", match.Status)
+	}
+}
+
+func TestMatch_Apply_RosterLockedAfterKickoff(t *testing.T) {
+	match := newScheduledMatch()
+	match.Status = MatchInProgress
+
+	fields := validationFields(t, match.Apply(MatchPatch{HomeTeam: &Team{PlayerIDs: []uint{1, 9}}}))
+	if fields[0] != "status" {
+		t.Errorf("Apply() fields = %v, want [status]", fields)
+	}
+
+	// Enviar a mesma escalação (em outra ordem) junto com o placar não é alteração.
+	if err := match.Apply(MatchPatch{HomeTeam: &Team{PlayerIDs: []uint{2, 1}, Score: intPtr(2)}}); err != nil {
+		t.Errorf("Apply() same roster error = %v", err)
+	}
+}
+
+func TestMatch_Validate_RSVPRules(t *testing.T) {
+	m := newScheduledMatch()
+	m.MaxPlayers = -1
+	late := m.KickoffAt.Add(time.Hour)
+	m.RSVPDeadline = &late
+
+	fields := validationFields(t, m.Validate())
+	if len(fields) != 2 || fields[0] != "max_players" || fields[1] != "rsvp_deadline" {
+		t.Errorf("Validate() fields = %v, want [max_players rsvp_deadline]", fields)
+	}
+}
+
+func TestMatch_CheckRSVP(t *testing.T) {
+	m := newScheduledMatch()
+	deadline := m.KickoffAt.Add(-24 * time.Hour)
+
+	if err := m.CheckRSVP(m.KickoffAt.Add(-time.Minute)); err != nil {
+		t.Errorf("CheckRSVP() before kickoff error = %v, want nil", err)
+	}
+	m.RSVPDeadline = &deadline
+	if fields := validationFields(t, m.CheckRSVP(deadline.Add(time.Minute))); fields[0] != "rsvp_deadline" {
+		t.Errorf("CheckRSVP() after deadline fields = %v, want rsvp_deadline", fields)
+	}
+	m.Status = MatchCancelled
+	if fields := validationFields(t, m.CheckRSVP(deadline.Add(-time.Hour))); fields[0] != "match" {
+		t.Errorf("CheckRSVP() cancelled fields = %v, want match", fields)
+	}
+}
+
+func TestMatch_Apply_RSVPRulesLockedAfterKickoff(t *testing.T) {
+	m := newScheduledMatch()
+	if err := m.Apply(MatchPatch{MaxPlayers: intPtr(10)}); err != nil || m.MaxPlayers != 10 {
+		t.Fatalf("Apply() max players = %d, %v, want 10", m.MaxPlayers, err)
+	}
+
+	started := MatchInProgress
+	if err := m.Apply(MatchPatch{Status: &started}); err != nil {
+		t.Fatalf("Apply() start error = %v", err)
+	}
+	if fields := validationFields(t, m.Apply(MatchPatch{MaxPlayers: intPtr(12)})); fields[0] != "status" {
+		t.Errorf("Apply() after kickoff fields = %v, want status", fields)
+	}
+}
@@ -0,0 +1,41 @@
+package domain
+
+type (
+	// PageRequest é a página pedida pelo cliente. Sort lista campos separados por vírgula,
+	// com "-" para ordem decrescente ("-overall,name"); Filters usa o nome do campo na API.
+	PageRequest struct {
+		Page     int
+		PageSize int
+		Sort     string
+		Search   string
+		Filters  map[string]string
+	}
+
+	Page[T any] struct {
+		Items      []T
+		Total      int64
+		Page       int
+		PageSize   int
+		TotalPages int
+	}
+)
+
+// NewPage calcula o total de páginas; a lista vazia ainda ocupa uma página.
+func NewPage[T any](items []T, total int64, page, pageSize int) Page[T] {
+	totalPages := 1
+	if pageSize > 0 && total > 0 {
+		totalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+	}
+	if items == nil {
+		items = []T{}
+	}
+	return Page[T]{Items: items, Total: total, Page: page, PageSize: pageSize, TotalPages: totalPages}
+}
+
+func (p Page[T]) HasNext() bool {
+	return p.Page < p.TotalPages
+}
+
+func (p Page[T]) HasPrev() bool {
+	return p.Page > 1
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "testing"
+
+func TestNewPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int64
+		page      int
+		wantPages int
+		wantNext  bool
+		wantPrev  bool
+	}{
+		{"empty", 0, 1, 1, false, false},
+		{"exact", 20, 1, 2, true, false},
+		{"partial last page", 21, 3, 3, false, true},
+		{"middle", 45, 2, 5, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := NewPage[int](nil, tt.total, tt.page, 10)
+			if page.TotalPages != tt.wantPages || page.HasNext() != tt.wantNext || page.HasPrev() != tt.wantPrev {
+				t.Errorf("NewPage() = %+v, next = %v, prev = %v", page, page.HasNext(), page.HasPrev())
+			}
+			if page.Items == nil {
+				t.Error("NewPage() items should be an empty slice, not nil")
+			}
+		})
+	}
+}
+
+func TestPlayer_Overall_UsesFirstPosition(t *testing.T) {
+	stats := Stats{Finishing: 50, Passing: 70, Speed: 70, Defense: 90, Stamina: 70, Highlight: 70}
+	defender := Player{Stats: stats, Position: []string{"Zagueiro", "Atacante"}}
+
+	if got := defender.Overall(); got != stats.Overall(LineDefense) {
+		t.Errorf("Overall() = %d, want %d", got, stats.Overall(LineDefense))
+	}
+}
@@ -6,16 +6,28 @@ type (
 	Player struct {
 		ID       uint
 		Name     string
-		Stats    map[string]interface{}
+		Stats    Stats
 		Position []string
 	}
 
-	Position struct {
-		Name string
+	// PlayerUpdate separa o perfil (nome e posições), que o próprio jogador edita, dos
+	// stats, que vêm das notas e só quem gerencia jogadores sobrescreve. Stats nil mantém
+	// os atuais.
+	PlayerUpdate struct {
+		Name     string
+		Position []string
+		Stats    *Stats
+	}
+
+	// PlayerProfile é o jogador que a própria conta cria ao entrar em um grupo: só nome e
+	// posições, já que os stats vêm das notas.
+	PlayerProfile struct {
+		Name     string
+		Position []string
 	}
 )
 
-func NewPlayer(name string, stats map[string]interface{}, position []string) *Player {
+func NewPlayer(name string, stats Stats, position []string) *Player {
 	return &Player{
 		Name:     name,
 		Stats:    stats,
@@ -23,15 +35,18 @@ func NewPlayer(name string, stats map[string]interface{}, position []string) *Pl
 	}
 }
 
+// Overall pondera os stats pelo setor da primeira posição, como na carta sem notas.
+func (p Player) Overall() int {
+	return p.Stats.Overall(primaryLine(p.Position))
+}
+
 func (p Player) Validate() error {
 	var errs errors.ValidationErrors
 
 	if p.Name == "" {
 		errs.Append("name", "Name is required")
 	}
-	if len(p.Stats) != 6 {
-		errs.Append("stats", "Stats must contain exactly 6 keys")
-	}
+	p.Stats.Validate("stats.", &errs)
 	if len(p.Position) == 0 {
 		errs.Append("positions", "At least one position is required")
 	}
@@ -41,3 +56,36 @@ func (p Player) Validate() error {
 	}
 	return nil
 }
+
+func (u PlayerUpdate) Validate() error {
+	var errs errors.ValidationErrors
+
+	if u.Name == "" {
+		errs.Append("name", "Name is required")
+	}
+	if u.Stats != nil {
+		u.Stats.Validate("stats.", &errs)
+	}
+	if len(u.Position) == 0 {
+		errs.Append("positions", "At least one position is required")
+	}
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+// ToPlayer começa o jogador com NeutralStats até ele receber notas.
+func (p PlayerProfile) ToPlayer() Player {
+	return Player{Name: p.Name, Stats: NeutralStats(), Position: p.Position}
+}
+
+// Apply copia o perfil e, quando enviados, os stats sobre o jogador.
+func (p *Player) Apply(u PlayerUpdate) {
+	p.Name = u.Name
+	p.Position = u.Position
+	if u.Stats != nil {
+		p.Stats = *u.Stats
+	}
+}
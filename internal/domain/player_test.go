@@ -6,18 +6,15 @@ import (
 	"fut-app/internal/errors"
 )
 
+func validStats() Stats {
+	return Stats{Finishing: 90, Passing: 88, Speed: 99, Defense: 60, Stamina: 85, Highlight: 95}
+}
+
 func TestPlayer_Validate_Success(t *testing.T) {
 	// Arrange
 	player := Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    validStats(),
 		Position: []string{"Atacante"},
 	}
 
@@ -32,15 +29,8 @@ func TestPlayer_Validate_Success(t *testing.T) {
 func TestPlayer_Validate_EmptyName(t *testing.T) {
 	// Arrange
 	player := Player{
-		Name: "", // Empty name should cause validation error
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "", // Empty name should cause validation error
+		Stats:    validStats(),
 		Position: []string{"Atacante"},
 	}
 
@@ -70,15 +60,14 @@ func TestPlayer_Validate_EmptyName(t *testing.T) {
 	}
 }
 
-func TestPlayer_Validate_WrongStatsCount(t *testing.T) {
+func TestPlayer_Validate_StatsOutOfRange(t *testing.T) {
 	// Arrange
+	stats := validStats()
+	stats.Speed = 100
+	stats.Defense = 44
 	player := Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade": 99,
-			"drible":     95,
-			// Only 2 stats instead of 6
-		},
+		Name:     "Pelé",
+		Stats:    stats,
 		Position: []string{"Atacante"},
 	}
 
@@ -95,31 +84,24 @@ func TestPlayer_Validate_WrongStatsCount(t *testing.T) {
 		t.Fatalf("Validate() error type = %T, want *errors.ValidationErrors", err)
 	}
 
-	if len(*validationErr) != 1 {
-		t.Errorf("Validate() validation errors count = %v, want 1", len(*validationErr))
+	if len(*validationErr) != 2 {
+		t.Fatalf("Validate() validation errors count = %v, want 2", len(*validationErr))
 	}
 
-	if (*validationErr)[0].Field != "stats" {
-		t.Errorf("Validate() validation error field = %v, want 'stats'", (*validationErr)[0].Field)
+	if (*validationErr)[0].Field != "stats.speed" || (*validationErr)[1].Field != "stats.defense" {
+		t.Errorf("Validate() validation error fields = %v, want stats.speed and stats.defense", *validationErr)
 	}
 
-	if (*validationErr)[0].Message != "Stats must contain exactly 6 keys" {
-		t.Errorf("Validate() validation error message = %v, want 'Stats must contain exactly 6 keys'", (*validationErr)[0].Message)
+	if (*validationErr)[0].Message != "speed must be between 45 and 99" {
+		t.Errorf("Validate() validation error message = %v, want 'speed must be between 45 and 99'", (*validationErr)[0].Message)
 	}
 }
 
 func TestPlayer_Validate_EmptyPositions(t *testing.T) {
 	// Arrange
 	player := Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    validStats(),
 		Position: []string{}, // Empty positions should cause validation error
 	}
 
@@ -152,12 +134,9 @@ func TestPlayer_Validate_EmptyPositions(t *testing.T) {
 func TestPlayer_Validate_MultipleErrors(t *testing.T) {
 	// Arrange
 	player := Player{
-		Name: "", // Empty name
-		Stats: map[string]interface{}{
-			"velocidade": 99,
-			// Only 1 stat instead of 6
-		},
-		Position: []string{}, // Empty positions
+		Name:     "",                              // Empty name
+		Stats:    Stats{Finishing: 90, Speed: 99}, // Only 2 stats filled in
+		Position: []string{},                      // Empty positions
 	}
 
 	// Act
@@ -173,17 +152,17 @@ func TestPlayer_Validate_MultipleErrors(t *testing.T) {
 		t.Fatalf("Validate() error type = %T, want *errors.ValidationErrors", err)
 	}
 
-	if len(*validationErr) != 3 {
-		t.Errorf("Validate() validation errors count = %v, want 3", len(*validationErr))
+	if len(*validationErr) != 6 {
+		t.Errorf("Validate() validation errors count = %v, want 6", len(*validationErr))
 	}
 
-	// Check that all three validation errors are present
+	// Check that the name, each unset stat and the positions are reported
 	fields := make(map[string]bool)
 	for _, ve := range *validationErr {
 		fields[ve.Field] = true
 	}
 
-	expectedFields := []string{"name", "stats", "positions"}
+	expectedFields := []string{"name", "stats.passing", "stats.defense", "stats.stamina", "stats.highlight", "positions"}
 	for _, field := range expectedFields {
 		if !fields[field] {
 			t.Errorf("Validate() missing validation error for field: %s", field)
@@ -194,15 +173,8 @@ func TestPlayer_Validate_MultipleErrors(t *testing.T) {
 func TestPlayer_Validate_ValidPlayerWithMultiplePositions(t *testing.T) {
 	// Arrange
 	player := Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    validStats(),
 		Position: []string{"Atacante", "Meio-campo"},
 	}
 
@@ -214,18 +186,11 @@ func TestPlayer_Validate_ValidPlayerWithMultiplePositions(t *testing.T) {
 	}
 }
 
-func TestPlayer_Validate_ExactStatsCount(t *testing.T) {
+func TestPlayer_Validate_BoundaryStats(t *testing.T) {
 	// Arrange
 	player := Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    Stats{Finishing: 45, Passing: 99, Speed: 45, Defense: 99, Stamina: 45, Highlight: 99},
 		Position: []string{"Atacante"},
 	}
 
@@ -237,55 +202,10 @@ func TestPlayer_Validate_ExactStatsCount(t *testing.T) {
 	}
 }
 
-func TestPlayer_Validate_TooManyStats(t *testing.T) {
-	// Arrange
-	player := Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-			"extra":       100, // 7 stats instead of 6
-		},
-		Position: []string{"Atacante"},
-	}
-
-	// Act
-	err := player.Validate()
-
-	// Assert
-	if err == nil {
-		t.Fatal("Validate() error = nil, want validation error")
-	}
-
-	validationErr, ok := err.(*errors.ValidationErrors)
-	if !ok {
-		t.Fatalf("Validate() error type = %T, want *errors.ValidationErrors", err)
-	}
-
-	if len(*validationErr) != 1 {
-		t.Errorf("Validate() validation errors count = %v, want 1", len(*validationErr))
-	}
-
-	if (*validationErr)[0].Field != "stats" {
-		t.Errorf("Validate() validation error field = %v, want 'stats'", (*validationErr)[0].Field)
-	}
-}
-
 func TestNewPlayer(t *testing.T) {
 	// Arrange
 	name := "Pelé"
-	stats := map[string]interface{}{
-		"velocidade":  99,
-		"drible":      95,
-		"finalizacao": 90,
-		"passe":       88,
-		"defesa":      60,
-		"fisico":      85,
-	}
+	stats := validStats()
 	positions := []string{"Atacante", "Meio-campo"}
 
 	// Act
@@ -300,21 +220,14 @@ func TestNewPlayer(t *testing.T) {
 		t.Errorf("NewPlayer() name = %v, want %v", player.Name, name)
 	}
 
-	if len(player.Stats) != len(stats) {
-		t.Errorf("NewPlayer() stats length = %v, want %v", len(player.Stats), len(stats))
+	if player.Stats != stats {
+		t.Errorf("NewPlayer() stats = %+v, want %+v", player.Stats, stats)
 	}
 
 	if len(player.Position) != len(positions) {
 		t.Errorf("NewPlayer() position length = %v, want %v", len(player.Position), len(positions))
 	}
 
-	// Check if all stats are present
-	for key, value := range stats {
-		if player.Stats[key] != value {
-			t.Errorf("NewPlayer() stats[%s] = %v, want %v", key, player.Stats[key], value)
-		}
-	}
-
 	// Check if all positions are present
 	for i, pos := range positions {
 		if player.Position[i] != pos {
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	"fut-app/internal/errors"
+)
+
+// Role é o papel da conta ou do membro de um grupo. Toda conta nova é RolePlayer; só um
+// admin muda o papel da conta, e organizadores mudam o papel dos membros do grupo.
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"
+	RoleOrganiser Role = "organiser"
+	RolePlayer    Role = "player"
+)
+
+// Roles lista os papéis válidos, do mais ao menos privilegiado.
+var Roles = []Role{RoleAdmin, RoleOrganiser, RolePlayer}
+
+// Permission é uma ação que depende do papel de quem faz a requisição.
+type Permission string
+
+const (
+	// PermManageMatches cobre criar e editar partidas, sortear os times, responder à
+	// convocação por outro jogador e registrar as faltas.
+	PermManageMatches Permission = "matches:manage"
+	// PermManagePlayers cobre cadastrar, editar e remover qualquer jogador e sobrescrever
+	// stats; sem ela, a conta só edita o perfil (nome e posições) do próprio jogador.
+	PermManagePlayers   Permission = "players:manage"
+	PermManagePositions Permission = "positions:manage"
+	PermMigrateStats    Permission = "stats:migrate"
+	PermManageAccounts  Permission = "accounts:manage"
+	// PermManageGroup cobre trocar o código de convite e o papel dos membros do grupo.
+	PermManageGroup Permission = "group:manage"
+	// PermRateOnBehalf permite enviar notas por outro jogador, como os convidados que não
+	// têm conta.
+	PermRateOnBehalf Permission = "ratings:on-behalf"
+)
+
+var rolePermissions = map[Role]map[Permission]bool{
+	RoleAdmin: {
+		PermManageMatches:   true,
+		PermManagePlayers:   true,
+		PermManagePositions: true,
+		PermMigrateStats:    true,
+		PermManageAccounts:  true,
+		PermManageGroup:     true,
+		PermRateOnBehalf:    true,
+	},
+	RoleOrganiser: {
+		PermManageMatches: true,
+		PermManagePlayers: true,
+		PermManageGroup:   true,
+	},
+	RolePlayer: {},
+}
+
+func (r Role) Validate() error {
+	if _, ok := rolePermissions[r]; !ok {
+		var errs errors.ValidationErrors
+		errs.Append("role", fmt.Sprintf("Role must be one of %v", Roles))
+		return &errs
+	}
+	return nil
+}
+
+// outranks compara papéis pela ordem de Roles; um papel desconhecido não supera nenhum.
+func (r Role) outranks(other Role) bool {
+	rank := func(role Role) int {
+		for i, known := range Roles {
+			if role == known {
+				return len(Roles) - i
+			}
+		}
+		return 0
+	}
+	return rank(r) > rank(other)
+}
+
+// Can diz se o papel concede a permissão; um papel desconhecido não concede nada.
+func (r Role) Can(perm Permission) bool {
+	return rolePermissions[r][perm]
+}
+
+// EffectiveRole é o maior entre o papel da conta e o papel dela no grupo da requisição:
+// um organizador do grupo organiza só ali, e um admin da conta vale em todos os grupos.
+func EffectiveRole(ctx context.Context) (Role, bool) {
+	claims, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	role := claims.Role
+	if m, ok := MembershipFromContext(ctx); ok && m.Role.outranks(role) {
+		role = m.Role
+	}
+	return role, true
+}
+
+// Authorize confere se o principal da requisição tem a permissão. Sem principal o erro é
+// ErrUnauthorized; com principal e sem permissão, ErrForbidden.
+func Authorize(ctx context.Context, perm Permission) error {
+	role, ok := EffectiveRole(ctx)
+	if !ok {
+		return errors.ErrUnauthorized
+	}
+	if !role.Can(perm) {
+		return fmt.Errorf("%w: %s requires %s", errors.ErrForbidden, role, perm)
+	}
+	return nil
+}
+
+// AuthorizePlayer libera a ação sobre o próprio jogador da conta no grupo da requisição;
+// sobre outro jogador, exige a permissão.
+func AuthorizePlayer(ctx context.Context, playerID uint, perm Permission) error {
+	if _, ok := PrincipalFromContext(ctx); !ok {
+		return errors.ErrUnauthorized
+	}
+	if m, ok := MembershipFromContext(ctx); ok && m.PlayerID != 0 && m.PlayerID == playerID {
+		return nil
+	}
+	return Authorize(ctx, perm)
+}
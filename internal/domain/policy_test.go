@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "fut-app/internal/errors"
+)
+
+// principalContext é a conta playerID com o papel role, membro comum do grupo 1 com o
+// jogador de mesmo ID.
+func principalContext(playerID uint, role Role) context.Context {
+	ctx := WithPrincipal(context.Background(), TokenClaims{Principal: Principal{AccountID: playerID, Role: role}})
+	return WithMembership(ctx, Membership{GroupID: 1, AccountID: playerID, PlayerID: playerID, Role: RolePlayer})
+}
+
+func TestRole_Can(t *testing.T) {
+	tests := []struct {
+		role Role
+		perm Permission
+		want bool
+	}{
+		{RoleAdmin, PermManageAccounts, true},
+		{RoleAdmin, PermManageMatches, true},
+		{RoleOrganiser, PermManageMatches, true},
+		{RoleOrganiser, PermManagePlayers, true},
+		{RoleOrganiser, PermManagePositions, false},
+		{RoleOrganiser, PermManageAccounts, false},
+		{RolePlayer, PermManageMatches, false},
+		{RolePlayer, PermManagePlayers, false},
+		{Role("captain"), PermManageMatches, false},
+	}
+	for _, tt := range tests {
+		if got := tt.role.Can(tt.perm); got != tt.want {
+			t.Errorf("%s.Can(%s) = %v, want %v", tt.role, tt.perm, got, tt.want)
+		}
+	}
+}
+
+func TestRole_Validate(t *testing.T) {
+	for _, role := range Roles {
+		if err := role.Validate(); err != nil {
+			t.Errorf("%s.Validate() error = %v, want nil", role, err)
+		}
+	}
+	var ve *apperrors.ValidationErrors
+	if err := Role("captain").Validate(); !errors.As(err, &ve) {
+		t.Errorf("Validate() error = %v, want *ValidationErrors", err)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	if err := Authorize(context.Background(), PermManageMatches); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("Authorize() without principal error = %v, want ErrUnauthorized", err)
+	}
+	if err := Authorize(principalContext(1, RolePlayer), PermManageMatches); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Errorf("Authorize() as player error = %v, want ErrForbidden", err)
+	}
+	if err := Authorize(principalContext(1, RoleOrganiser), PermManageMatches); err != nil {
+		t.Errorf("Authorize() as organiser error = %v, want nil", err)
+	}
+}
+
+func TestAuthorizePlayer(t *testing.T) {
+	if err := AuthorizePlayer(principalContext(4, RolePlayer), 4, PermManagePlayers); err != nil {
+		t.Errorf("AuthorizePlayer() own player error = %v, want nil", err)
+	}
+	if err := AuthorizePlayer(principalContext(4, RolePlayer), 5, PermManagePlayers); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Errorf("AuthorizePlayer() other player error = %v, want ErrForbidden", err)
+	}
+	if err := AuthorizePlayer(principalContext(4, RoleOrganiser), 5, PermManagePlayers); err != nil {
+		t.Errorf("AuthorizePlayer() as organiser error = %v, want nil", err)
+	}
+	if err := AuthorizePlayer(context.Background(), 4, PermManagePlayers); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("AuthorizePlayer() without principal error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestEffectiveRole(t *testing.T) {
+	organiser := WithMembership(principalContext(4, RolePlayer), Membership{GroupID: 1, AccountID: 4, PlayerID: 4, Role: RoleOrganiser})
+	if role, _ := EffectiveRole(organiser); role != RoleOrganiser {
+		t.Errorf("EffectiveRole() group organiser = %v, want organiser", role)
+	}
+	// O papel do grupo não rebaixa o admin da conta.
+	if role, _ := EffectiveRole(principalContext(4, RoleAdmin)); role != RoleAdmin {
+		t.Errorf("EffectiveRole() account admin = %v, want admin", role)
+	}
+	if _, ok := EffectiveRole(context.Background()); ok {
+		t.Error("EffectiveRole() ok = true without principal")
+	}
+}
+
+func TestAuthorizePlayer_OutsideGroup(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), TokenClaims{Principal: Principal{AccountID: 4, Role: RolePlayer}})
+	if err := AuthorizePlayer(ctx, 4, PermManagePlayers); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Errorf("AuthorizePlayer() without membership error = %v, want ErrForbidden", err)
+	}
+}
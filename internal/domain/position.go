@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"fut-app/internal/errors"
+)
+
+// Line é o setor do campo em que uma posição atua.
+type Line string
+
+const (
+	LineGoal     Line = "goal"
+	LineDefense  Line = "defense"
+	LineMidfield Line = "midfield"
+	LineAttack   Line = "attack"
+
+	MaxAbbreviationLength = 5
+)
+
+// Lines lista os setores válidos, do gol para o ataque.
+var Lines = []Line{LineGoal, LineDefense, LineMidfield, LineAttack}
+
+type (
+	// Position é uma posição do catálogo; jogadores a referenciam pelo nome ou pela sigla.
+	Position struct {
+		ID           uint
+		Name         string
+		Abbreviation string
+		Line         Line
+	}
+
+	PositionPatch struct {
+		Name         *string
+		Abbreviation *string
+		Line         *Line
+	}
+
+	// PositionCatalog resolve o setor de uma posição pelo nome ou pela sigla, sem
+	// diferenciar maiúsculas.
+	PositionCatalog map[string]Line
+)
+
+// StandardPositions é o catálogo semeado na inicialização.
+var StandardPositions = []Position{
+	{Name: "Goleiro", Abbreviation: "GK", Line: LineGoal},
+	{Name: "Zagueiro", Abbreviation: "CB", Line: LineDefense},
+	{Name: "Lateral esquerdo", Abbreviation: "LB", Line: LineDefense},
+	{Name: "Lateral direito", Abbreviation: "RB", Line: LineDefense},
+	{Name: "Volante", Abbreviation: "CDM", Line: LineMidfield},
+	{Name: "Meio-campo", Abbreviation: "CM", Line: LineMidfield},
+	{Name: "Meia ofensivo", Abbreviation: "CAM", Line: LineMidfield},
+	{Name: "Ponta esquerda", Abbreviation: "LW", Line: LineAttack},
+	{Name: "Ponta direita", Abbreviation: "RW", Line: LineAttack},
+	{Name: "Atacante", Abbreviation: "ST", Line: LineAttack},
+}
+
+// defaultLines reconhece o catálogo padrão e nomes antigos usados no cadastro.
+var defaultLines = func() PositionCatalog {
+	catalog := NewPositionCatalog(StandardPositions)
+	for name, line := range map[string]Line{
+		"lateral": LineDefense, "meia": LineMidfield, "ponta": LineAttack, "cf": LineAttack,
+	} {
+		catalog[name] = line
+	}
+	return catalog
+}()
+
+// LineOf retorna o setor de uma posição do catálogo padrão, ou "" quando ela não é conhecida.
+func LineOf(position string) Line {
+	return defaultLines[positionKey(position)]
+}
+
+func NewPositionCatalog(positions []Position) PositionCatalog {
+	catalog := make(PositionCatalog, 2*len(positions))
+	for _, p := range positions {
+		if p.Line == "" {
+			continue
+		}
+		catalog[positionKey(p.Name)] = p.Line
+		if p.Abbreviation != "" {
+			catalog[positionKey(p.Abbreviation)] = p.Line
+		}
+	}
+	return catalog
+}
+
+// LineOf procura a posição no catálogo e recorre ao catálogo padrão quando ela não está cadastrada.
+func (c PositionCatalog) LineOf(position string) Line {
+	if line, ok := c[positionKey(position)]; ok {
+		return line
+	}
+	return LineOf(position)
+}
+
+func positionKey(position string) string {
+	return strings.ToLower(strings.TrimSpace(position))
+}
+
+func NewPosition(name, abbreviation string, line Line) *Position {
+	return &Position{
+		Name:         strings.TrimSpace(name),
+		Abbreviation: strings.ToUpper(strings.TrimSpace(abbreviation)),
+		Line:         line,
+	}
+}
+
+func (l Line) IsValid() bool {
+	for _, line := range Lines {
+		if l == line {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Position) Validate() error {
+	var errs errors.ValidationErrors
+
+	if strings.TrimSpace(p.Name) == "" {
+		errs.Append("name", "Name is required")
+	}
+	if abbr := strings.TrimSpace(p.Abbreviation); abbr == "" {
+		errs.Append("abbreviation", "Abbreviation is required")
+	} else if len(abbr) > MaxAbbreviationLength {
+		errs.Append("abbreviation", fmt.Sprintf("Abbreviation must have at most %d characters", MaxAbbreviationLength))
+	}
+	if !p.Line.IsValid() {
+		errs.Append("line", fmt.Sprintf("Line must be one of %v", Lines))
+	}
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
+
+// Apply aplica os campos informados no patch, normalizando a sigla em maiúsculas.
+func (p *Position) Apply(patch PositionPatch) error {
+	if patch.Name != nil {
+		p.Name = strings.TrimSpace(*patch.Name)
+	}
+	if patch.Abbreviation != nil {
+		p.Abbreviation = strings.ToUpper(strings.TrimSpace(*patch.Abbreviation))
+	}
+	if patch.Line != nil {
+		p.Line = *patch.Line
+	}
+	return p.Validate()
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+
+	"fut-app/internal/errors"
+)
+
+func TestLineOf_StandardPositions(t *testing.T) {
+	for _, p := range StandardPositions {
+		if got := LineOf(p.Name); got != p.Line {
+			t.Errorf("LineOf(%q) = %q, want %q", p.Name, got, p.Line)
+		}
+		if got := LineOf(p.Abbreviation); got != p.Line {
+			t.Errorf("LineOf(%q) = %q, want %q", p.Abbreviation, got, p.Line)
+		}
+	}
+	if got := LineOf(" st "); got != LineAttack {
+		t.Errorf("LineOf() should ignore case and spaces, got %q", got)
+	}
+	if got := LineOf("Gandula"); got != "" {
+		t.Errorf("LineOf() unknown = %q, want empty", got)
+	}
+}
+
+func TestPositionCatalog_LineOf(t *testing.T) {
+	catalog := NewPositionCatalog([]Position{
+		{Name: "Líbero", Abbreviation: "LIB", Line: LineDefense},
+		{Name: "Legado"},
+	})
+
+	if got := catalog.LineOf("lib"); got != LineDefense {
+		t.Errorf("LineOf(lib) = %q, want defense", got)
+	}
+	if got := catalog.LineOf("Goleiro"); got != LineGoal {
+		t.Errorf("LineOf(Goleiro) = %q, want fallback to the standard catalog", got)
+	}
+	if got := catalog.LineOf("Legado"); got != "" {
+		t.Errorf("LineOf(Legado) = %q, want empty for positions without line", got)
+	}
+}
+
+func TestNewPosition_Normalizes(t *testing.T) {
+	p := NewPosition(" Líbero ", " lib", LineDefense)
+
+	if p.Name != "Líbero" || p.Abbreviation != "LIB" {
+		t.Errorf("NewPosition() = %+v", p)
+	}
+}
+
+func TestPosition_Validate(t *testing.T) {
+	if err := (Position{Name: "Líbero", Abbreviation: "LIB", Line: LineDefense}).Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+
+	err := Position{Abbreviation: "LIBERO", Line: "bench"}.Validate()
+	ve, ok := err.(*errors.ValidationErrors)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want *errors.ValidationErrors", err)
+	}
+	want := []string{"name", "abbreviation", "line"}
+	if len(*ve) != len(want) {
+		t.Fatalf("Validate() errors = %v, want fields %v", *ve, want)
+	}
+	for i, field := range want {
+		if (*ve)[i].Field != field {
+			t.Errorf("Validate() field[%d] = %q, want %q", i, (*ve)[i].Field, field)
+		}
+	}
+}
+
+func TestPosition_Apply(t *testing.T) {
+	p := Position{ID: 1, Name: "Meia", Abbreviation: "MEI", Line: LineMidfield}
+	name, abbr, line := "Meia-atacante", "sa", LineAttack
+
+	if err := p.Apply(PositionPatch{Name: &name, Abbreviation: &abbr, Line: &line}); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if p.Name != "Meia-atacante" || p.Abbreviation != "SA" || p.Line != LineAttack {
+		t.Errorf("Apply() = %+v", p)
+	}
+
+	empty := ""
+	if err := p.Apply(PositionPatch{Name: &empty}); err == nil {
+		t.Error("Apply() with empty name should fail")
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"fut-app/internal/errors"
+)
+
+const (
+	MinAttribute = 45
+	MaxAttribute = 99
+)
+
+type (
+	Rating struct {
+		ID            uint
+		MatchID       uint
+		RaterID       uint
+		RatedPlayerID uint
+		Finishing     int
+		Passing       int
+		Speed         int
+		Defense       int
+		Stamina       int
+		Highlight     int
+		CreatedAt     time.Time
+	}
+
+	// RatingSubmission é o conjunto de notas que um participante dá aos demais em uma partida.
+	RatingSubmission struct {
+		MatchID uint
+		RaterID uint
+		Ratings []Rating
+	}
+)
+
+// Stats retorna os seis atributos da nota.
+func (r Rating) Stats() Stats {
+	return Stats{
+		Finishing: r.Finishing,
+		Passing:   r.Passing,
+		Speed:     r.Speed,
+		Defense:   r.Defense,
+		Stamina:   r.Stamina,
+		Highlight: r.Highlight,
+	}
+}
+
+// Validate confere a submissão contra a partida: só participantes avaliam participantes,
+// ninguém se avalia e cada jogador recebe no máximo uma nota do mesmo avaliador por partida.
+func (s RatingSubmission) Validate(match Match, alreadyRated []uint) error {
+	var errs errors.ValidationErrors
+
+	if match.Status != MatchFinished {
+		errs.Append("match", "Ratings can only be submitted for finished matches")
+	}
+	if !match.HasPlayer(s.RaterID) {
+		errs.Append("rater_id", fmt.Sprintf("Player %d did not play in this match", s.RaterID))
+	}
+	if len(s.Ratings) == 0 {
+		errs.Append("ratings", "At least one rating is required")
+	}
+
+	rated := make(map[uint]bool, len(alreadyRated)+len(s.Ratings))
+	for _, id := range alreadyRated {
+		rated[id] = true
+	}
+
+	for i, r := range s.Ratings {
+		prefix := fmt.Sprintf("ratings[%d].", i)
+		switch {
+		case r.RatedPlayerID == s.RaterID:
+			errs.Append(prefix+"player_id", "A player cannot rate themselves")
+		case !match.HasPlayer(r.RatedPlayerID):
+			errs.Append(prefix+"player_id", fmt.Sprintf("Player %d did not play in this match", r.RatedPlayerID))
+		case rated[r.RatedPlayerID]:
+			errs.Append(prefix+"player_id", fmt.Sprintf("Player %d was already rated by this player in this match", r.RatedPlayerID))
+		}
+		rated[r.RatedPlayerID] = true
+		r.Stats().Validate(prefix, &errs)
+	}
+
+	if errs.HasErrors() {
+		return &errs
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+)
+
+func newFinishedMatch() Match {
+	match := newScheduledMatch()
+	match.Status = MatchFinished
+	match.HomeTeam.Score = intPtr(2)
+	match.AwayTeam.Score = intPtr(1)
+	return match
+}
+
+func validRating(ratedPlayerID uint) Rating {
+	return Rating{
+		RatedPlayerID: ratedPlayerID,
+		Finishing:     70,
+		Passing:       75,
+		Speed:         80,
+		Defense:       60,
+		Stamina:       85,
+		Highlight:     65,
+	}
+}
+
+func TestRatingSubmission_Validate_Success(t *testing.T) {
+	submission := RatingSubmission{
+		MatchID: 1,
+		RaterID: 1,
+		Ratings: []Rating{validRating(2), validRating(3)},
+	}
+
+	if err := submission.Validate(newFinishedMatch(), nil); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestRatingSubmission_Validate_MatchNotFinished(t *testing.T) {
+	submission := RatingSubmission{RaterID: 1, Ratings: []Rating{validRating(2)}}
+
+	fields := validationFields(t, submission.Validate(newScheduledMatch(), nil))
+	if len(fields) != 1 || fields[0] != "match" {
+		t.Errorf("Validate() fields = %v, want [match]", fields)
+	}
+}
+
+func TestRatingSubmission_Validate_Participants(t *testing.T) {
+	submission := RatingSubmission{
+		RaterID: 9,
+		Ratings: []Rating{validRating(2), validRating(9), validRating(42)},
+	}
+
+	fields := validationFields(t, submission.Validate(newFinishedMatch(), nil))
+	want := []string{"rater_id", "ratings[1].player_id", "ratings[2].player_id"}
+	if len(fields) != len(want) {
+		t.Fatalf("Validate() fields = %v, want %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("Validate() field[%d] = %v, want %v", i, fields[i], want[i])
+		}
+	}
+}
+
+func TestRatingSubmission_Validate_SelfRating(t *testing.T) {
+	submission := RatingSubmission{RaterID: 1, Ratings: []Rating{validRating(1)}}
+
+	fields := validationFields(t, submission.Validate(newFinishedMatch(), nil))
+	if len(fields) != 1 || fields[0] != "ratings[0].player_id" {
+		t.Errorf("Validate() fields = %v, want [ratings[0].player_id]", fields)
+	}
+}
+
+func TestRatingSubmission_Validate_OncePerMatch(t *testing.T) {
+	submission := RatingSubmission{
+		RaterID: 1,
+		Ratings: []Rating{validRating(2), validRating(2), validRating(3)},
+	}
+
+	fields := validationFields(t, submission.Validate(newFinishedMatch(), []uint{3}))
+	want := []string{"ratings[1].player_id", "ratings[2].player_id"}
+	if len(fields) != len(want) || fields[0] != want[0] || fields[1] != want[1] {
+		t.Errorf("Validate() fields = %v, want %v", fields, want)
+	}
+}
+
+func TestRatingSubmission_Validate_AttributeRange(t *testing.T) {
+	rating := validRating(2)
+	rating.Finishing = 44
+	rating.Highlight = 100
+
+	submission := RatingSubmission{RaterID: 1, Ratings: []Rating{rating}}
+
+	fields := validationFields(t, submission.Validate(newFinishedMatch(), nil))
+	want := []string{"ratings[0].finishing", "ratings[0].highlight"}
+	if len(fields) != len(want) || fields[0] != want[0] || fields[1] != want[1] {
+		t.Errorf("Validate() fields = %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"fut-app/internal/errors"
+)
+
+// Stats são os seis atributos do jogador, na mesma escala de 45 a 99 das notas.
+type Stats struct {
+	Finishing int `json:"finishing"`
+	Passing   int `json:"passing"`
+	Speed     int `json:"speed"`
+	Defense   int `json:"defense"`
+	Stamina   int `json:"stamina"`
+	Highlight int `json:"highlight"`
+}
+
+// NeutralStats põe todos os atributos no meio da escala, para quem ainda não foi avaliado.
+func NeutralStats() Stats {
+	const neutral = (MinAttribute + MaxAttribute) / 2
+	return Stats{Finishing: neutral, Passing: neutral, Speed: neutral, Defense: neutral, Stamina: neutral, Highlight: neutral}
+}
+
+// StatNames lista os atributos na ordem usada nas validações e no JSONB.
+var StatNames = []string{"finishing", "passing", "speed", "defense", "stamina", "highlight"}
+
+// statAliases aceita as chaves em português do cadastro antigo ao migrar o JSONB.
+var statAliases = map[string]string{
+	"finalizacao": "finishing",
+	"finalização": "finishing",
+	"passe":       "passing",
+	"velocidade":  "speed",
+	"defesa":      "defense",
+	"resistencia": "stamina",
+	"resistência": "stamina",
+	"fisico":      "stamina",
+	"físico":      "stamina",
+	"destaque":    "highlight",
+}
+
+func (s Stats) values() map[string]int {
+	return map[string]int{
+		"finishing": s.Finishing,
+		"passing":   s.Passing,
+		"speed":     s.Speed,
+		"defense":   s.Defense,
+		"stamina":   s.Stamina,
+		"highlight": s.Highlight,
+	}
+}
+
+func (s *Stats) set(name string, value int) {
+	switch name {
+	case "finishing":
+		s.Finishing = value
+	case "passing":
+		s.Passing = value
+	case "speed":
+		s.Speed = value
+	case "defense":
+		s.Defense = value
+	case "stamina":
+		s.Stamina = value
+	case "highlight":
+		s.Highlight = value
+	}
+}
+
+// Validate adiciona um erro por atributo fora da faixa, com o campo prefixado por prefix.
+func (s Stats) Validate(prefix string, errs *errors.ValidationErrors) {
+	values := s.values()
+	for _, name := range StatNames {
+		if v := values[name]; v < MinAttribute || v > MaxAttribute {
+			errs.Append(prefix+name, fmt.Sprintf("%s must be between %d and %d", name, MinAttribute, MaxAttribute))
+		}
+	}
+}
+
+// ToMap converte para o formato gravado no JSONB.
+func (s Stats) ToMap() map[string]interface{} {
+	m := make(map[string]interface{}, len(StatNames))
+	for name, v := range s.values() {
+		m[name] = v
+	}
+	return m
+}
+
+// StatsFromMap lê o JSONB gravado sem validar; chaves ausentes ou inválidas ficam zeradas.
+func StatsFromMap(m map[string]interface{}) Stats {
+	var s Stats
+	for _, name := range StatNames {
+		if v, ok := toInt(m[name]); ok {
+			s.set(name, v)
+		}
+	}
+	return s
+}
+
+// ParseStats lê um JSONB possivelmente legado: aceita as chaves em português, mas
+// reporta chaves desconhecidas, atributos ausentes, valores não inteiros e fora da faixa.
+func ParseStats(m map[string]interface{}) (Stats, error) {
+	var (
+		s    Stats
+		errs errors.ValidationErrors
+		seen = make(map[string]bool, len(StatNames))
+	)
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name := strings.ToLower(strings.TrimSpace(key))
+		if alias, ok := statAliases[name]; ok {
+			name = alias
+		}
+		if !isStatName(name) {
+			errs.Append("stats."+key, "Unknown attribute")
+			continue
+		}
+		if seen[name] {
+			errs.Append("stats."+key, fmt.Sprintf("Attribute %s is set more than once", name))
+			continue
+		}
+		seen[name] = true
+
+		v, ok := toInt(m[key])
+		if !ok {
+			errs.Append("stats."+name, fmt.Sprintf("%s must be an integer", name))
+			continue
+		}
+		s.set(name, v)
+	}
+
+	for _, name := range StatNames {
+		if !seen[name] {
+			errs.Append("stats."+name, fmt.Sprintf("%s is required", name))
+		}
+	}
+	if !errs.HasErrors() {
+		s.Validate("stats.", &errs)
+	}
+
+	if errs.HasErrors() {
+		return Stats{}, &errs
+	}
+	return s, nil
+}
+
+// Overall é a média dos atributos ponderada pelo setor; setor desconhecido usa pesos iguais.
+func (s Stats) Overall(line Line) int {
+	w, ok := lineWeights[line]
+	if !ok {
+		w = evenWeights
+	}
+	total := s.Finishing*w.Finishing + s.Passing*w.Passing + s.Speed*w.Speed +
+		s.Defense*w.Defense + s.Stamina*w.Stamina + s.Highlight*w.Highlight
+	return int(math.Round(float64(total) / 100))
+}
+
+func isStatName(name string) bool {
+	for _, n := range StatNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, false
+		}
+		return int(n), true
+	}
+	return 0, false
+}
+
+type (
+	// StoredStats é o JSONB de stats de um jogador como está gravado, antes da conversão.
+	StoredStats struct {
+		PlayerID uint
+		Name     string
+		Raw      map[string]interface{}
+	}
+
+	InvalidStats struct {
+		PlayerID uint
+		Name     string
+		Errors   errors.ValidationErrors
+	}
+
+	// StatsMigrationReport resume a conversão do JSONB legado para Stats: Migrated lista
+	// os jogadores regravados com as chaves canônicas e Invalid os que precisam de correção manual.
+	StatsMigrationReport struct {
+		DryRun   bool
+		Checked  int
+		Migrated []uint
+		Invalid  []InvalidStats
+	}
+)
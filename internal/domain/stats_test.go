@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+
+	"fut-app/internal/errors"
+)
+
+func TestStats_Validate_ReportsEachField(t *testing.T) {
+	var errs errors.ValidationErrors
+	Stats{Finishing: 44, Passing: 70, Speed: 100, Defense: 70, Stamina: 70, Highlight: 70}.Validate("stats.", &errs)
+
+	if len(errs) != 2 {
+		t.Fatalf("Validate() errors = %v, want 2", errs)
+	}
+	if errs[0].Field != "stats.finishing" || errs[1].Field != "stats.speed" {
+		t.Errorf("Validate() fields = %v, want stats.finishing and stats.speed", errs)
+	}
+}
+
+func TestStats_ToMap_RoundTrip(t *testing.T) {
+	stats := Stats{Finishing: 90, Passing: 80, Speed: 70, Defense: 60, Stamina: 50, Highlight: 99}
+
+	m := stats.ToMap()
+	if len(m) != 6 || m["finishing"] != 90 {
+		t.Fatalf("ToMap() = %v", m)
+	}
+	if got := StatsFromMap(m); got != stats {
+		t.Errorf("StatsFromMap() = %+v, want %+v", got, stats)
+	}
+}
+
+func TestStatsFromMap_IgnoresUnknownKeys(t *testing.T) {
+	got := StatsFromMap(map[string]interface{}{"finishing": 80.0, "drible": 95, "speed": "x"})
+
+	if got != (Stats{Finishing: 80}) {
+		t.Errorf("StatsFromMap() = %+v, want only finishing", got)
+	}
+}
+
+func TestParseStats_AcceptsLegacyKeys(t *testing.T) {
+	got, err := ParseStats(map[string]interface{}{
+		"finalizacao": 85.0, "passe": 92.0, "velocidade": 70.0,
+		"defesa": 60.0, "fisico": 75.0, "destaque": 90.0,
+	})
+	if err != nil {
+		t.Fatalf("ParseStats() error = %v", err)
+	}
+
+	want := Stats{Finishing: 85, Passing: 92, Speed: 70, Defense: 60, Stamina: 75, Highlight: 90}
+	if got != want {
+		t.Errorf("ParseStats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseStats_ReportsInvalidFields(t *testing.T) {
+	_, err := ParseStats(map[string]interface{}{
+		"finishing": 80.0, "finalizacao": 81.0, "passing": "x",
+		"speed": 70.5, "defense": 60.0, "stamina": 75.0, "drible": 90.0,
+	})
+
+	ve, ok := err.(*errors.ValidationErrors)
+	if !ok {
+		t.Fatalf("ParseStats() error type = %T, want *errors.ValidationErrors", err)
+	}
+
+	fields := make(map[string]bool)
+	for _, e := range *ve {
+		fields[e.Field] = true
+	}
+	for _, field := range []string{"stats.drible", "stats.finishing", "stats.passing", "stats.speed", "stats.highlight"} {
+		if !fields[field] {
+			t.Errorf("ParseStats() missing error for %s, got %v", field, *ve)
+		}
+	}
+}
+
+func TestParseStats_OutOfRange(t *testing.T) {
+	_, err := ParseStats(Stats{Finishing: 100, Passing: 70, Speed: 70, Defense: 70, Stamina: 70, Highlight: 70}.ToMap())
+
+	ve, ok := err.(*errors.ValidationErrors)
+	if !ok || len(*ve) != 1 || (*ve)[0].Field != "stats.finishing" {
+		t.Errorf("ParseStats() error = %v, want stats.finishing out of range", err)
+	}
+}
@@ -1,10 +1,14 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"net/http"
 )
 
+// StatusClientClosedRequest é o 499 do nginx: o cliente desistiu antes da resposta.
+const StatusClientClosedRequest = 499
+
 type HTTPError struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
@@ -12,6 +16,12 @@ type HTTPError struct {
 
 func ToHTTPError(err error) (int, HTTPError) {
 	switch {
+	// O contexto vem antes dos sentinels: uma consulta cancelada pode vir embrulhada junto
+	// com o erro do driver.
+	case errors.Is(err, context.Canceled):
+		return StatusClientClosedRequest, HTTPError{"client_closed_request", "Client closed request"}
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, HTTPError{"timeout", "Request timed out"}
 	case errors.Is(err, ErrNotFound):
 		return http.StatusNotFound, HTTPError{"not_found", "Resource not found"}
 	case errors.Is(err, ErrBadRequest):
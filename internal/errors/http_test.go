@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -75,6 +76,27 @@ func TestToHTTPError(t *testing.T) {
 			expectedCode:   "database_error",
 			expectedMsg:    "Database error",
 		},
+		{
+			name:           "Context canceled",
+			inputError:     context.Canceled,
+			expectedStatus: StatusClientClosedRequest,
+			expectedCode:   "client_closed_request",
+			expectedMsg:    "Client closed request",
+		},
+		{
+			name:           "Context deadline exceeded",
+			inputError:     context.DeadlineExceeded,
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCode:   "timeout",
+			expectedMsg:    "Request timed out",
+		},
+		{
+			name:           "Deadline wins over wrapped database error",
+			inputError:     errors.Join(context.DeadlineExceeded, ErrDatabase),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCode:   "timeout",
+			expectedMsg:    "Request timed out",
+		},
 		{
 			name:           "Unknown error",
 			inputError:     errors.New("some unknown error"),
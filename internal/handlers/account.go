@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type AccountHandler struct {
+	changeRole usecase.ChangeAccountRoleUseCase
+}
+
+func NewAccountHandler(changeRole usecase.ChangeAccountRoleUseCase) *AccountHandler {
+	return &AccountHandler{changeRole: changeRole}
+}
+
+func (h *AccountHandler) ChangeRole(w http.ResponseWriter, r *http.Request, body dto.AccountRoleDTO) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	account, err := h.changeRole.Execute(r.Context(), id, domain.Role(body.Role))
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, dto.NewAccountResponse(*account))
+}
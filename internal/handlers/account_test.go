@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubChangeAccountRoleUseCase struct {
+	executeFn func(uint, domain.Role) (*domain.Account, error)
+}
+
+func (s *stubChangeAccountRoleUseCase) Execute(_ context.Context, id uint, role domain.Role) (*domain.Account, error) {
+	return s.executeFn(id, role)
+}
+
+func TestAccountHandler_ChangeRole_Success(t *testing.T) {
+	uc := &stubChangeAccountRoleUseCase{
+		executeFn: func(id uint, role domain.Role) (*domain.Account, error) {
+			return &domain.Account{ID: id, Email: "zico@fut.app", Role: role}, nil
+		},
+	}
+	h := NewAccountHandler(uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, "/accounts/5/role", nil), map[string]string{"id": "5"})
+
+	if err := h.ChangeRole(rr, req, dto.AccountRoleDTO{Role: "organiser"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	var body dto.AccountResponse
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if body.ID != 5 || body.Role != "organiser" {
+		t.Errorf("unexpected response: %+v", body)
+	}
+}
+
+func TestAccountHandler_ChangeRole_Forbidden(t *testing.T) {
+	uc := &stubChangeAccountRoleUseCase{
+		executeFn: func(uint, domain.Role) (*domain.Account, error) { return nil, appErr.ErrForbidden },
+	}
+	h := NewAccountHandler(uc)
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, "/accounts/5/role", nil), map[string]string{"id": "5"})
+
+	if err := h.ChangeRole(httptest.NewRecorder(), req, dto.AccountRoleDTO{Role: "admin"}); !errors.Is(err, appErr.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
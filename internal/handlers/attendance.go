@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type AttendanceHandler struct {
+	rsvp          usecase.RSVPUseCase
+	getRoster     usecase.GetRosterUseCase
+	recordNoShows usecase.RecordNoShowsUseCase
+}
+
+func NewAttendanceHandler(
+	rsvp usecase.RSVPUseCase,
+	getRoster usecase.GetRosterUseCase,
+	recordNoShows usecase.RecordNoShowsUseCase,
+) *AttendanceHandler {
+	return &AttendanceHandler{
+		rsvp:          rsvp,
+		getRoster:     getRoster,
+		recordNoShows: recordNoShows,
+	}
+}
+
+func (h *AttendanceHandler) RSVP(w http.ResponseWriter, r *http.Request, d dto.RSVPDTO) error {
+	matchID, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	roster, err := h.rsvp.Execute(r.Context(), d.ToDomain(matchID))
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, roster)
+}
+
+func (h *AttendanceHandler) GetRoster(w http.ResponseWriter, r *http.Request) error {
+	matchID, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	roster, err := h.getRoster.Execute(r.Context(), matchID)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, roster)
+}
+
+func (h *AttendanceHandler) RecordNoShows(w http.ResponseWriter, r *http.Request, d dto.NoShowsDTO) error {
+	matchID, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	roster, err := h.recordNoShows.Execute(r.Context(), matchID, d.PlayerIDs)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, roster)
+}
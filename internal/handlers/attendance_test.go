@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubRSVPUseCase struct {
+	executeFn func(domain.RSVP) (*domain.Roster, error)
+}
+
+func (s *stubRSVPUseCase) Execute(_ context.Context, rsvp domain.RSVP) (*domain.Roster, error) {
+	return s.executeFn(rsvp)
+}
+
+func TestAttendanceHandler_RSVP(t *testing.T) {
+	tests := []struct {
+		status string
+		going  bool
+	}{
+		{"going", true},
+		{"declined", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			uc := &stubRSVPUseCase{
+				executeFn: func(rsvp domain.RSVP) (*domain.Roster, error) {
+					if rsvp.MatchID != 4 || rsvp.PlayerID != 0 || rsvp.Going != tt.going {
+						t.Fatalf("unexpected rsvp: %+v", rsvp)
+					}
+					return &domain.Roster{MatchID: rsvp.MatchID}, nil
+				},
+			}
+
+			h := NewAttendanceHandler(uc, nil, nil)
+			rr := httptest.NewRecorder()
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/matches/4/rsvp", nil), map[string]string{"id": "4"})
+
+			if err := h.RSVP(rr, req, dto.RSVPDTO{Status: tt.status}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rr.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type AuthHandler struct {
+	register usecase.RegisterAccountUseCase
+	login    usecase.LoginUseCase
+	refresh  usecase.RefreshTokenUseCase
+	logout   usecase.LogoutUseCase
+}
+
+func NewAuthHandler(
+	register usecase.RegisterAccountUseCase,
+	login usecase.LoginUseCase,
+	refresh usecase.RefreshTokenUseCase,
+	logout usecase.LogoutUseCase,
+) *AuthHandler {
+	return &AuthHandler{
+		register: register,
+		login:    login,
+		refresh:  refresh,
+		logout:   logout,
+	}
+}
+
+func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request, body dto.RegisterDTO) error {
+	account, err := h.register.Execute(r.Context(), body.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusCreated, dto.NewAccountResponse(*account))
+}
+
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request, body dto.LoginDTO) error {
+	tokens, err := h.login.Execute(r.Context(), body.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, dto.NewTokenResponse(*tokens))
+}
+
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request, body dto.RefreshTokenDTO) error {
+	tokens, err := h.refresh.Execute(r.Context(), body.RefreshToken)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, dto.NewTokenResponse(*tokens))
+}
+
+// Logout roda atrás do middleware de autenticação. O corpo é opcional, então não passa
+// pelo ValidateJSON: sem corpo, só o token de acesso é revogado.
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) error {
+	claims, ok := domain.PrincipalFromContext(r.Context())
+	if !ok {
+		return appErr.ErrUnauthorized
+	}
+
+	var body dto.LogoutDTO
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		return appErr.ErrInvalidData
+	}
+
+	if err := h.logout.Execute(r.Context(), domain.Logout{Access: claims, RefreshToken: body.RefreshToken}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
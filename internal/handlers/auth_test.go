@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+)
+
+type stubLoginUseCase struct {
+	executeFn func(domain.Credentials) (*domain.TokenPair, error)
+}
+
+func (s *stubLoginUseCase) Execute(_ context.Context, c domain.Credentials) (*domain.TokenPair, error) {
+	return s.executeFn(c)
+}
+
+type stubLogoutUseCase struct {
+	got *domain.Logout
+}
+
+func (s *stubLogoutUseCase) Execute(_ context.Context, l domain.Logout) error {
+	s.got = &l
+	return nil
+}
+
+func TestAuthHandler_Login_Success(t *testing.T) {
+	uc := &stubLoginUseCase{
+		executeFn: func(c domain.Credentials) (*domain.TokenPair, error) {
+			if c.Email != "zico@fut.app" || c.Password != "galinho1" {
+				t.Fatalf("unexpected credentials: %+v", c)
+			}
+			return &domain.TokenPair{AccessToken: "a", RefreshToken: "r", TokenType: domain.TokenTypeBearer, ExpiresIn: 15 * time.Minute}, nil
+		},
+	}
+	h := NewAuthHandler(nil, uc, nil, nil)
+	rr := httptest.NewRecorder()
+
+	err := h.Login(rr, httptest.NewRequest(http.MethodPost, "/auth/login", nil), dto.LoginDTO{Email: "zico@fut.app", Password: "galinho1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var body dto.TokenResponse
+	if err := json.NewDecoder(rr.Body).Decode(&body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if body.AccessToken != "a" || body.TokenType != "Bearer" || body.ExpiresIn != 900 {
+		t.Errorf("unexpected response: %+v", body)
+	}
+}
+
+func TestAuthHandler_Login_Unauthorized(t *testing.T) {
+	uc := &stubLoginUseCase{
+		executeFn: func(domain.Credentials) (*domain.TokenPair, error) { return nil, appErr.ErrUnauthorized },
+	}
+	h := NewAuthHandler(nil, uc, nil, nil)
+
+	err := h.Login(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/auth/login", nil), dto.LoginDTO{})
+	if !errors.Is(err, appErr.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	claims := domain.TokenClaims{Principal: domain.Principal{AccountID: 1}, ID: "a1", Kind: domain.AccessToken}
+
+	tests := []struct {
+		name        string
+		body        string
+		wantRefresh string
+		wantErr     error
+	}{
+		{name: "without body"},
+		{name: "with refresh token", body: `{"refresh_token":"r1"}`, wantRefresh: "r1"},
+		{name: "unknown field", body: `{"token":"r1"}`, wantErr: appErr.ErrInvalidData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &stubLogoutUseCase{}
+			h := NewAuthHandler(nil, nil, nil, uc)
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", strings.NewReader(tt.body))
+			req = req.WithContext(domain.WithPrincipal(req.Context(), claims))
+
+			err := h.Logout(rr, req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rr.Code != http.StatusNoContent {
+				t.Fatalf("expected status %d, got %d", http.StatusNoContent, rr.Code)
+			}
+			if uc.got.Access.ID != "a1" || uc.got.RefreshToken != tt.wantRefresh {
+				t.Errorf("unexpected logout: %+v", uc.got)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Logout_WithoutPrincipal(t *testing.T) {
+	h := NewAuthHandler(nil, nil, nil, &stubLogoutUseCase{})
+
+	err := h.Logout(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/auth/logout", nil))
+	if !errors.Is(err, appErr.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"fut-app/internal/domain"
+)
+
+type (
+	// RSVPDTO responde pelo jogador da conta quando player_id não vem no corpo.
+	RSVPDTO struct {
+		PlayerID uint   `json:"player_id"`
+		Status   string `json:"status" validate:"required,oneof=going declined"`
+	}
+
+	// NoShowsDTO é a lista completa de quem confirmou e faltou; reenviá-la substitui a
+	// anterior.
+	NoShowsDTO struct {
+		PlayerIDs []uint `json:"player_ids" validate:"dive,gt=0"`
+	}
+)
+
+func (r *RSVPDTO) ToDomain(matchID uint) domain.RSVP {
+	return domain.RSVP{
+		MatchID:  matchID,
+		PlayerID: r.PlayerID,
+		Going:    r.Status == string(domain.AttendanceGoing),
+	}
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"fut-app/internal/domain"
+)
+
+type (
+	// RegisterDTO só cria a conta; o jogador nasce ao criar ou entrar num grupo. O formato
+	// do e-mail e o tamanho da senha são validados no domínio, campo a campo.
+	RegisterDTO struct {
+		Email    string `json:"email" validate:"required"`
+		Password string `json:"password" validate:"required"`
+	}
+
+	LoginDTO struct {
+		Email    string `json:"email" validate:"required"`
+		Password string `json:"password" validate:"required"`
+	}
+
+	RefreshTokenDTO struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+	}
+
+	// AccountRoleDTO troca o papel de uma conta; os valores aceitos são validados no domínio.
+	AccountRoleDTO struct {
+		Role string `json:"role" validate:"required"`
+	}
+
+	// LogoutDTO é opcional: sem refresh_token, só o token de acesso é revogado.
+	LogoutDTO struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	AccountResponse struct {
+		ID        uint      `json:"id"`
+		Email     string    `json:"email"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	TokenResponse struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		TokenType    string `json:"token_type"`
+		// ExpiresIn é a validade do token de acesso em segundos.
+		ExpiresIn int64 `json:"expires_in"`
+	}
+)
+
+func (r *RegisterDTO) ToDomain() domain.Registration {
+	return domain.Registration{
+		Email:    r.Email,
+		Password: r.Password,
+	}
+}
+
+func (l *LoginDTO) ToDomain() domain.Credentials {
+	return domain.Credentials{Email: l.Email, Password: l.Password}
+}
+
+func NewAccountResponse(a domain.Account) AccountResponse {
+	return AccountResponse{
+		ID:        a.ID,
+		Email:     a.Email,
+		Role:      string(a.Role),
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func NewTokenResponse(p domain.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:  p.AccessToken,
+		RefreshToken: p.RefreshToken,
+		TokenType:    p.TokenType,
+		ExpiresIn:    int64(p.ExpiresIn.Seconds()),
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"fut-app/internal/domain"
+)
+
+type DrawTeamsDTO struct {
+	PlayerIDs    []uint   `json:"player_ids" validate:"omitempty,dive,gt=0"`
+	Teams        int      `json:"teams" validate:"omitempty,min=2"`
+	KeepApart    [][]uint `json:"keep_apart" validate:"omitempty,dive,min=2"`
+	KeepTogether [][]uint `json:"keep_together" validate:"omitempty,dive,min=2"`
+}
+
+func (d *DrawTeamsDTO) ToDomain(matchID uint) domain.DrawRequest {
+	teams := d.Teams
+	if teams == 0 {
+		teams = domain.MinTeams
+	}
+	return domain.DrawRequest{
+		MatchID:      matchID,
+		PlayerIDs:    d.PlayerIDs,
+		Teams:        teams,
+		KeepApart:    d.KeepApart,
+		KeepTogether: d.KeepTogether,
+	}
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"fut-app/internal/domain"
+)
+
+type (
+	// GroupDTO cria o grupo e, com Player, o jogador de quem o cria.
+	GroupDTO struct {
+		Name   string           `json:"name" validate:"required"`
+		Player PlayerProfileDTO `json:"player"`
+	}
+
+	// JoinGroupDTO entra no grupo do código criando o jogador da conta nele.
+	JoinGroupDTO struct {
+		Code   string           `json:"code" validate:"required"`
+		Player PlayerProfileDTO `json:"player"`
+	}
+
+	// MemberRoleDTO troca o papel de um membro; os valores aceitos são validados no domínio.
+	MemberRoleDTO struct {
+		Role string `json:"role" validate:"required"`
+	}
+
+	GroupResponse struct {
+		ID        uint      `json:"id"`
+		Name      string    `json:"name"`
+		JoinCode  string    `json:"join_code"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	MemberResponse struct {
+		PlayerID  uint   `json:"player_id"`
+		AccountID uint   `json:"account_id"`
+		Name      string `json:"name"`
+		Role      string `json:"role"`
+	}
+)
+
+func (g *GroupDTO) ToDomain() domain.NewGroup {
+	return domain.NewGroup{Name: g.Name, Player: g.Player.ToDomain()}
+}
+
+func (j *JoinGroupDTO) ToDomain() domain.JoinGroup {
+	return domain.JoinGroup{Code: j.Code, Player: j.Player.ToDomain()}
+}
+
+func NewGroupResponse(g domain.Group) GroupResponse {
+	return GroupResponse{
+		ID:        g.ID,
+		Name:      g.Name,
+		JoinCode:  g.JoinCode,
+		CreatedAt: g.CreatedAt,
+	}
+}
+
+func NewMemberResponse(m domain.GroupMember) MemberResponse {
+	return MemberResponse{
+		PlayerID:  m.PlayerID,
+		AccountID: m.AccountID,
+		Name:      m.Name,
+		Role:      string(m.Role),
+	}
+}
@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"time"
+
+	"fut-app/internal/domain"
+)
+
+type (
+	TeamDTO struct {
+		Name    string `json:"name" validate:"required"`
+		Players []uint `json:"players" validate:"dive,gt=0"`
+	}
+
+	MatchDTO struct {
+		Venue        string     `json:"venue" validate:"required"`
+		KickoffAt    time.Time  `json:"kickoff_at" validate:"required"`
+		HomeTeam     TeamDTO    `json:"home_team"`
+		AwayTeam     TeamDTO    `json:"away_team"`
+		MaxPlayers   int        `json:"max_players" validate:"min=0"`
+		RSVPDeadline *time.Time `json:"rsvp_deadline"`
+	}
+
+	TeamPatchDTO struct {
+		Name    string `json:"name"`
+		Players []uint `json:"players" validate:"omitempty,dive,gt=0"`
+		Score   *int   `json:"score" validate:"omitempty,min=0"`
+	}
+
+	MatchPatchDTO struct {
+		Venue     *string       `json:"venue" validate:"omitempty,min=1"`
+		KickoffAt *time.Time    `json:"kickoff_at"`
+		Status    *string       `json:"status" validate:"omitempty,oneof=scheduled in_progress finished cancelled"`
+		HomeTeam  *TeamPatchDTO `json:"home_team"`
+		AwayTeam  *TeamPatchDTO `json:"away_team"`
+		// MaxPlayers zero tira o limite de confirmados.
+		MaxPlayers   *int       `json:"max_players" validate:"omitempty,min=0"`
+		RSVPDeadline *time.Time `json:"rsvp_deadline"`
+	}
+)
+
+func (t *TeamDTO) ToDomain() domain.Team {
+	players := t.Players
+	if players == nil {
+		players = []uint{}
+	}
+	return domain.Team{
+		Name:      t.Name,
+		PlayerIDs: players,
+	}
+}
+
+func (m *MatchDTO) ToDomain() domain.Match {
+	match := domain.NewMatch(m.Venue, m.KickoffAt, m.HomeTeam.ToDomain(), m.AwayTeam.ToDomain())
+	match.MaxPlayers = m.MaxPlayers
+	match.RSVPDeadline = m.RSVPDeadline
+	return *match
+}
+
+func (t *TeamPatchDTO) ToDomain() *domain.Team {
+	if t == nil {
+		return nil
+	}
+	return &domain.Team{
+		Name:      t.Name,
+		PlayerIDs: t.Players,
+		Score:     t.Score,
+	}
+}
+
+func (m *MatchPatchDTO) ToDomain() domain.MatchPatch {
+	patch := domain.MatchPatch{
+		Venue:        m.Venue,
+		KickoffAt:    m.KickoffAt,
+		HomeTeam:     m.HomeTeam.ToDomain(),
+		AwayTeam:     m.AwayTeam.ToDomain(),
+		MaxPlayers:   m.MaxPlayers,
+		RSVPDeadline: m.RSVPDeadline,
+	}
+	if m.Status != nil {
+		status := domain.MatchStatus(*m.Status)
+		patch.Status = &status
+	}
+	return patch
+}
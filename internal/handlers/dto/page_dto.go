@@ -0,0 +1,29 @@
+package dto
+
+import "fut-app/internal/domain"
+
+type (
+	PageResponse[T any] struct {
+		Data       []T           `json:"data"`
+		Pagination PaginationDTO `json:"pagination"`
+	}
+
+	PaginationDTO struct {
+		Page       int   `json:"page"`
+		PageSize   int   `json:"page_size"`
+		Total      int64 `json:"total"`
+		TotalPages int   `json:"total_pages"`
+	}
+)
+
+func NewPageResponse[T any](page domain.Page[T]) PageResponse[T] {
+	return PageResponse[T]{
+		Data: page.Items,
+		Pagination: PaginationDTO{
+			Page:       page.Page,
+			PageSize:   page.PageSize,
+			Total:      page.Total,
+			TotalPages: page.TotalPages,
+		},
+	}
+}
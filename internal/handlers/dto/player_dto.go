@@ -6,20 +6,63 @@ import (
 
 type (
 	PlayerDTO struct {
-		Name     string                 `json:"name" validate:"required"`
-		Stats    map[string]interface{} `json:"stats" validate:"required,statslen"`
-		Position []string               `json:"positions" validate:"required,min=1,dive,required"`
+		Name     string   `json:"name" validate:"required"`
+		Stats    StatsDTO `json:"stats"`
+		Position []string `json:"positions" validate:"required,min=1,dive,required"`
 	}
 
-	PositionDTO struct {
-		Name string `json:"name" validate:"required"`
+	// PlayerUpdateDTO é o PUT do jogador: stats ausentes mantêm os atuais.
+	PlayerUpdateDTO struct {
+		Name     string    `json:"name" validate:"required"`
+		Stats    *StatsDTO `json:"stats"`
+		Position []string  `json:"positions" validate:"required,min=1,dive,required"`
+	}
+
+	// PlayerProfileDTO é o jogador criado ao entrar em um grupo, sem stats.
+	PlayerProfileDTO struct {
+		Name     string   `json:"name" validate:"required"`
+		Position []string `json:"positions" validate:"required,min=1,dive,required"`
+	}
+
+	// StatsDTO não valida a faixa dos atributos: domain.Stats devolve um erro por campo.
+	StatsDTO struct {
+		Finishing int `json:"finishing"`
+		Passing   int `json:"passing"`
+		Speed     int `json:"speed"`
+		Defense   int `json:"defense"`
+		Stamina   int `json:"stamina"`
+		Highlight int `json:"highlight"`
 	}
 )
 
 func (p *PlayerDTO) ToDomain() domain.Player {
 	return domain.Player{
 		Name:     p.Name,
-		Stats:    p.Stats,
+		Stats:    p.Stats.ToDomain(),
 		Position: p.Position,
 	}
 }
+
+func (p *PlayerProfileDTO) ToDomain() domain.PlayerProfile {
+	return domain.PlayerProfile{Name: p.Name, Position: p.Position}
+}
+
+func (p *PlayerUpdateDTO) ToDomain() domain.PlayerUpdate {
+	update := domain.PlayerUpdate{Name: p.Name, Position: p.Position}
+	if p.Stats != nil {
+		stats := p.Stats.ToDomain()
+		update.Stats = &stats
+	}
+	return update
+}
+
+func (s StatsDTO) ToDomain() domain.Stats {
+	return domain.Stats{
+		Finishing: s.Finishing,
+		Passing:   s.Passing,
+		Speed:     s.Speed,
+		Defense:   s.Defense,
+		Stamina:   s.Stamina,
+		Highlight: s.Highlight,
+	}
+}
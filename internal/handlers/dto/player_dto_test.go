@@ -8,7 +8,7 @@ import (
 func TestPlayerDTO_ToDomain(t *testing.T) {
 	d := PlayerDTO{
 		Name:     "Messi",
-		Stats:    map[string]interface{}{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6},
+		Stats:    StatsDTO{Finishing: 94, Passing: 91, Speed: 85, Defense: 40, Stamina: 72, Highlight: 99},
 		Position: []string{"RW", "CF"},
 	}
 
@@ -17,7 +17,7 @@ func TestPlayerDTO_ToDomain(t *testing.T) {
 	if got.Name != d.Name {
 		t.Fatalf("expected name %q, got %q", d.Name, got.Name)
 	}
-	if !reflect.DeepEqual(got.Stats, d.Stats) {
+	if got.Stats.Finishing != 94 || got.Stats.Defense != 40 || got.Stats.Highlight != 99 {
 		t.Fatalf("unexpected stats: %#v", got.Stats)
 	}
 	if !reflect.DeepEqual(got.Position, d.Position) {
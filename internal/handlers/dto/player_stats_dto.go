@@ -0,0 +1,5 @@
+package dto
+
+type MigratePlayerStatsDTO struct {
+	DryRun bool `json:"dry_run"`
+}
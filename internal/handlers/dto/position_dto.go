@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"fut-app/internal/domain"
+)
+
+type (
+	PositionDTO struct {
+		Name         string `json:"name" validate:"required"`
+		Abbreviation string `json:"abbreviation" validate:"required"`
+		Line         string `json:"line" validate:"required,oneof=goal defense midfield attack"`
+	}
+
+	PositionPatchDTO struct {
+		Name         *string `json:"name" validate:"omitempty,min=1"`
+		Abbreviation *string `json:"abbreviation" validate:"omitempty,min=1"`
+		Line         *string `json:"line" validate:"omitempty,oneof=goal defense midfield attack"`
+	}
+)
+
+func (p *PositionDTO) ToDomain() domain.Position {
+	return *domain.NewPosition(p.Name, p.Abbreviation, domain.Line(p.Line))
+}
+
+func (p *PositionPatchDTO) ToDomain() domain.PositionPatch {
+	patch := domain.PositionPatch{
+		Name:         p.Name,
+		Abbreviation: p.Abbreviation,
+	}
+	if p.Line != nil {
+		line := domain.Line(*p.Line)
+		patch.Line = &line
+	}
+	return patch
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"fut-app/internal/domain"
+)
+
+type (
+	RatingDTO struct {
+		PlayerID  uint `json:"player_id" validate:"required"`
+		Finishing int  `json:"finishing"`
+		Passing   int  `json:"passing"`
+		Speed     int  `json:"speed"`
+		Defense   int  `json:"defense"`
+		Stamina   int  `json:"stamina"`
+		Highlight int  `json:"highlight"`
+	}
+
+	// RatingSubmissionDTO só exige os ids; as faixas 45–99 são validadas no domínio
+	// para que cada atributo fora da faixa volte como erro de campo.
+	RatingSubmissionDTO struct {
+		RaterID uint        `json:"rater_id" validate:"required"`
+		Ratings []RatingDTO `json:"ratings" validate:"required,min=1,dive"`
+	}
+)
+
+func (r *RatingDTO) ToDomain() domain.Rating {
+	return domain.Rating{
+		RatedPlayerID: r.PlayerID,
+		Finishing:     r.Finishing,
+		Passing:       r.Passing,
+		Speed:         r.Speed,
+		Defense:       r.Defense,
+		Stamina:       r.Stamina,
+		Highlight:     r.Highlight,
+	}
+}
+
+func (s *RatingSubmissionDTO) ToDomain(matchID uint) domain.RatingSubmission {
+	ratings := make([]domain.Rating, len(s.Ratings))
+	for i := range s.Ratings {
+		ratings[i] = s.Ratings[i].ToDomain()
+	}
+	return domain.RatingSubmission{
+		MatchID: matchID,
+		RaterID: s.RaterID,
+		Ratings: ratings,
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type GroupHandler struct {
+	createGroup    usecase.CreateGroupUseCase
+	listGroups     usecase.ListGroupsUseCase
+	joinGroup      usecase.JoinGroupUseCase
+	listMembers    usecase.ListMembersUseCase
+	rotateJoinCode usecase.RotateJoinCodeUseCase
+	changeRole     usecase.ChangeMemberRoleUseCase
+}
+
+func NewGroupHandler(
+	create usecase.CreateGroupUseCase,
+	list usecase.ListGroupsUseCase,
+	join usecase.JoinGroupUseCase,
+	members usecase.ListMembersUseCase,
+	rotate usecase.RotateJoinCodeUseCase,
+	changeRole usecase.ChangeMemberRoleUseCase,
+) *GroupHandler {
+	return &GroupHandler{
+		createGroup:    create,
+		listGroups:     list,
+		joinGroup:      join,
+		listMembers:    members,
+		rotateJoinCode: rotate,
+		changeRole:     changeRole,
+	}
+}
+
+func (h *GroupHandler) CreateGroup(w http.ResponseWriter, r *http.Request, body dto.GroupDTO) error {
+	group, err := h.createGroup.Execute(r.Context(), body.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusCreated, dto.NewGroupResponse(*group))
+}
+
+func (h *GroupHandler) GetGroups(w http.ResponseWriter, r *http.Request) error {
+	groups, err := h.listGroups.Execute(r.Context())
+	if err != nil {
+		return err
+	}
+
+	response := make([]dto.GroupResponse, len(groups))
+	for i, g := range groups {
+		response[i] = dto.NewGroupResponse(g)
+	}
+	return httprespond.JSON(w, http.StatusOK, response)
+}
+
+func (h *GroupHandler) JoinGroup(w http.ResponseWriter, r *http.Request, body dto.JoinGroupDTO) error {
+	group, err := h.joinGroup.Execute(r.Context(), body.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusCreated, dto.NewGroupResponse(*group))
+}
+
+func (h *GroupHandler) GetMembers(w http.ResponseWriter, r *http.Request) error {
+	members, err := h.listMembers.Execute(r.Context())
+	if err != nil {
+		return err
+	}
+
+	response := make([]dto.MemberResponse, len(members))
+	for i, m := range members {
+		response[i] = dto.NewMemberResponse(m)
+	}
+	return httprespond.JSON(w, http.StatusOK, response)
+}
+
+func (h *GroupHandler) RotateJoinCode(w http.ResponseWriter, r *http.Request) error {
+	group, err := h.rotateJoinCode.Execute(r.Context())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, dto.NewGroupResponse(*group))
+}
+
+// ChangeMemberRole recebe no {id} o jogador do membro, que é como ele aparece no grupo.
+func (h *GroupHandler) ChangeMemberRole(w http.ResponseWriter, r *http.Request, body dto.MemberRoleDTO) error {
+	playerID, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	member, err := h.changeRole.Execute(r.Context(), playerID, domain.Role(body.Role))
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, dto.NewMemberResponse(*member))
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubCreateGroupUseCase struct{ got domain.NewGroup }
+
+func (s *stubCreateGroupUseCase) Execute(_ context.Context, g domain.NewGroup) (*domain.Group, error) {
+	s.got = g
+	return &domain.Group{ID: 1, Name: g.Name, JoinCode: "ABCD2345"}, nil
+}
+
+type stubListGroupsUseCase struct{}
+
+func (stubListGroupsUseCase) Execute(context.Context) ([]domain.Group, error) {
+	return []domain.Group{{ID: 1, Name: "Quarta"}, {ID: 2, Name: "Sábado"}}, nil
+}
+
+type stubJoinGroupUseCase struct{}
+
+func (stubJoinGroupUseCase) Execute(_ context.Context, j domain.JoinGroup) (*domain.Group, error) {
+	return nil, appErr.ErrNotFound
+}
+
+type stubListMembersUseCase struct{}
+
+func (stubListMembersUseCase) Execute(context.Context) ([]domain.GroupMember, error) {
+	return []domain.GroupMember{{Membership: domain.Membership{PlayerID: 3, Role: domain.RoleOrganiser}, Name: "Zico"}}, nil
+}
+
+type stubRotateJoinCodeUseCase struct{}
+
+func (stubRotateJoinCodeUseCase) Execute(context.Context) (*domain.Group, error) {
+	return nil, appErr.ErrForbidden
+}
+
+type stubChangeMemberRoleUseCase struct{}
+
+func (stubChangeMemberRoleUseCase) Execute(_ context.Context, playerID uint, role domain.Role) (*domain.GroupMember, error) {
+	return &domain.GroupMember{Membership: domain.Membership{PlayerID: playerID, Role: role}}, nil
+}
+
+func newTestGroupHandler(create *stubCreateGroupUseCase) *GroupHandler {
+	return NewGroupHandler(create, stubListGroupsUseCase{}, stubJoinGroupUseCase{}, stubListMembersUseCase{}, stubRotateJoinCodeUseCase{}, stubChangeMemberRoleUseCase{})
+}
+
+func TestGroupHandler_CreateGroup(t *testing.T) {
+	create := &stubCreateGroupUseCase{}
+	h := newTestGroupHandler(create)
+	rr := httptest.NewRecorder()
+
+	body := dto.GroupDTO{Name: "Quarta", Player: dto.PlayerProfileDTO{Name: "Zico", Position: []string{"MEI"}}}
+	if err := h.CreateGroup(rr, httptest.NewRequest(http.MethodPost, "/groups", nil), body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, rr.Code)
+	}
+	var got dto.GroupResponse
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.ID != 1 || got.JoinCode != "ABCD2345" || create.got.Player.Name != "Zico" {
+		t.Errorf("unexpected response: %+v, use case got %+v", got, create.got)
+	}
+}
+
+func TestGroupHandler_GetMembers(t *testing.T) {
+	h := newTestGroupHandler(&stubCreateGroupUseCase{})
+	rr := httptest.NewRecorder()
+
+	if err := h.GetMembers(rr, httptest.NewRequest(http.MethodGet, "/groups/1/members", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []dto.MemberResponse
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(got) != 1 || got[0].PlayerID != 3 || got[0].Role != "organiser" || got[0].Name != "Zico" {
+		t.Errorf("unexpected response: %+v", got)
+	}
+}
+
+func TestGroupHandler_ChangeMemberRole(t *testing.T) {
+	h := newTestGroupHandler(&stubCreateGroupUseCase{})
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, "/groups/1/members/5/role", nil), map[string]string{"id": "5"})
+
+	if err := h.ChangeMemberRole(rr, req, dto.MemberRoleDTO{Role: "organiser"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got dto.MemberResponse
+	if err := json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.PlayerID != 5 || got.Role != "organiser" {
+		t.Errorf("unexpected response: %+v", got)
+	}
+}
+
+func TestGroupHandler_Errors(t *testing.T) {
+	h := newTestGroupHandler(&stubCreateGroupUseCase{})
+
+	err := h.JoinGroup(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/groups/join", nil), dto.JoinGroupDTO{Code: "nope"})
+	if !errors.Is(err, appErr.ErrNotFound) {
+		t.Errorf("JoinGroup: expected ErrNotFound, got %v", err)
+	}
+	if err := h.RotateJoinCode(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/groups/1/join-code", nil)); !errors.Is(err, appErr.ErrForbidden) {
+		t.Errorf("RotateJoinCode: expected ErrForbidden, got %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/health"
+)
+
+type HealthHandler struct {
+	registry *health.Registry
+}
+
+func NewHealthHandler(registry *health.Registry) *HealthHandler {
+	return &HealthHandler{
+		registry: registry,
+	}
+}
+
+// Live só confirma que o processo responde; não consulta dependências, para que uma
+// queda do banco não faça o orquestrador reiniciar o container.
+func (h *HealthHandler) Live(w http.ResponseWriter, r *http.Request) error {
+	return httprespond.JSON(w, http.StatusOK, health.Report{Status: health.StatusUp, Checks: map[string]health.Result{}})
+}
+
+// Ready executa as verificações registradas e responde 503 se alguma crítica falhar.
+func (h *HealthHandler) Ready(w http.ResponseWriter, r *http.Request) error {
+	report := h.registry.Run(r.Context())
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+	return httprespond.JSON(w, status, report)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"fut-app/internal/health"
+)
+
+func TestHealthHandler_Ready(t *testing.T) {
+	checks := health.NewRegistry(time.Second)
+	checks.Register("database", true, func(ctx context.Context) (map[string]any, error) {
+		return map[string]any{"ping_ms": 0.4}, nil
+	})
+	h := NewHealthHandler(checks)
+
+	rr := httptest.NewRecorder()
+	if err := h.Ready(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", rr.Code)
+	}
+
+	var got health.Report
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if got.Status != health.StatusUp || got.Checks["database"].Details["ping_ms"] != 0.4 {
+		t.Errorf("body = %+v", got)
+	}
+}
+
+func TestHealthHandler_Ready_CriticalFailure(t *testing.T) {
+	checks := health.NewRegistry(time.Second)
+	checks.Register("database", true, func(ctx context.Context) (map[string]any, error) {
+		return nil, errors.New("connection refused")
+	})
+	h := NewHealthHandler(checks)
+
+	rr := httptest.NewRecorder()
+	if err := h.Ready(rr, httptest.NewRequest(http.MethodGet, "/health/ready", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", rr.Code)
+	}
+}
+
+func TestHealthHandler_Live_IgnoresDependencies(t *testing.T) {
+	checks := health.NewRegistry(time.Second)
+	checks.Register("database", true, func(ctx context.Context) (map[string]any, error) {
+		return nil, errors.New("connection refused")
+	})
+	h := NewHealthHandler(checks)
+
+	rr := httptest.NewRecorder()
+	if err := h.Live(rr, httptest.NewRequest(http.MethodGet, "/health/live", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", rr.Code)
+	}
+}
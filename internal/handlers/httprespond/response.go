@@ -13,3 +13,8 @@ func JSON(w http.ResponseWriter, status int, data interface{}) error {
 	}
 	return nil
 }
+
+func NoContent(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -123,3 +123,12 @@ func TestJSON_ComplexStruct(t *testing.T) {
 	assert.Equal(t, player.Positions, result.Positions)
 	assert.Equal(t, player.Stats, result.Stats)
 }
+
+func TestNoContent(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	err := NoContent(rr)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+	assert.Empty(t, rr.Body.String())
+}
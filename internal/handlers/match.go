@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type MatchHandler struct {
+	createMatch usecase.CreateMatchUseCase
+	getMatch    usecase.GetMatchUseCase
+	listMatches usecase.ListMatchesUseCase
+	updateMatch usecase.UpdateMatchUseCase
+}
+
+func NewMatchHandler(
+	create usecase.CreateMatchUseCase,
+	get usecase.GetMatchUseCase,
+	list usecase.ListMatchesUseCase,
+	update usecase.UpdateMatchUseCase,
+) *MatchHandler {
+	return &MatchHandler{
+		createMatch: create,
+		getMatch:    get,
+		listMatches: list,
+		updateMatch: update,
+	}
+}
+
+func (h *MatchHandler) CreateMatch(w http.ResponseWriter, r *http.Request, m dto.MatchDTO) error {
+	match, err := h.createMatch.Execute(r.Context(), m.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusCreated, match)
+}
+
+func (h *MatchHandler) GetMatchByID(w http.ResponseWriter, r *http.Request) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	match, err := h.getMatch.Execute(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, match)
+}
+
+func (h *MatchHandler) GetMatches(w http.ResponseWriter, r *http.Request) error {
+	matches, err := h.listMatches.Execute(r.Context())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, matches)
+}
+
+func (h *MatchHandler) UpdateMatch(w http.ResponseWriter, r *http.Request, m dto.MatchPatchDTO) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	match, err := h.updateMatch.Execute(r.Context(), id, m.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, match)
+}
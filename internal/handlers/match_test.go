@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"fut-app/internal/domain"
+	appErrors "fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubCreateMatchUseCase struct {
+	executeFn func(domain.Match) (*domain.Match, error)
+}
+
+func (s *stubCreateMatchUseCase) Execute(_ context.Context, m domain.Match) (*domain.Match, error) {
+	return s.executeFn(m)
+}
+
+type stubUpdateMatchUseCase struct {
+	executeFn func(uint, domain.MatchPatch) (*domain.Match, error)
+}
+
+func (s *stubUpdateMatchUseCase) Execute(_ context.Context, id uint, p domain.MatchPatch) (*domain.Match, error) {
+	return s.executeFn(id, p)
+}
+
+func TestMatchHandler_CreateMatch_Success(t *testing.T) {
+	input := dto.MatchDTO{
+		Venue:     "Society do Zé",
+		KickoffAt: time.Date(2026, 10, 20, 20, 0, 0, 0, time.UTC),
+		HomeTeam:  dto.TeamDTO{Name: "Colete", Players: []uint{1, 2}},
+		AwayTeam:  dto.TeamDTO{Name: "Sem colete"},
+	}
+
+	uc := &stubCreateMatchUseCase{
+		executeFn: func(m domain.Match) (*domain.Match, error) {
+			if m.Venue != input.Venue || len(m.HomeTeam.PlayerIDs) != 2 {
+				t.Fatalf("unexpected match: %+v", m)
+			}
+			m.ID = 1
+			return &m, nil
+		},
+	}
+
+	h := NewMatchHandler(uc, nil, nil, nil)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/matches", nil)
+
+	if err := h.CreateMatch(rr, req, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var got domain.Match
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if got.ID != 1 || got.Status != domain.MatchScheduled {
+		t.Fatalf("unexpected body: %+v", got)
+	}
+}
+
+func TestMatchHandler_UpdateMatch_PassesPatch(t *testing.T) {
+	status := "finished"
+	score := 4
+	input := dto.MatchPatchDTO{
+		Status:   &status,
+		HomeTeam: &dto.TeamPatchDTO{Score: &score},
+	}
+
+	uc := &stubUpdateMatchUseCase{
+		executeFn: func(id uint, p domain.MatchPatch) (*domain.Match, error) {
+			if id != 3 {
+				t.Fatalf("expected id 3, got %d", id)
+			}
+			if p.Status == nil || *p.Status != domain.MatchFinished {
+				t.Fatalf("unexpected status: %v", p.Status)
+			}
+			if p.HomeTeam == nil || *p.HomeTeam.Score != 4 || p.AwayTeam != nil {
+				t.Fatalf("unexpected teams: %+v / %+v", p.HomeTeam, p.AwayTeam)
+			}
+			return &domain.Match{ID: id, Status: *p.Status}, nil
+		},
+	}
+
+	h := NewMatchHandler(nil, nil, nil, uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPatch, "/matches/3", nil), map[string]string{"id": "3"})
+
+	if err := h.UpdateMatch(rr, req, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestMatchHandler_UpdateMatch_InvalidID(t *testing.T) {
+	h := NewMatchHandler(nil, nil, nil, nil)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPatch, "/matches/0", nil), map[string]string{"id": "0"})
+
+	if err := h.UpdateMatch(rr, req, dto.MatchPatchDTO{}); err != appErrors.ErrBadRequest {
+		t.Fatalf("expected ErrBadRequest, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"fut-app/pkg/logger"
+
+	"github.com/gorilla/mux"
+)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (w *statusRecorder) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// statusCode devolve o status escrito, ou 200 quando o handler não escreveu nada.
+func (w *statusRecorder) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Unwrap expõe o ResponseWriter original para o http.ResponseController.
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// routeTemplate devolve o template da rota que atende r, ou "" quando nenhuma atende.
+func routeTemplate(routes *mux.Router, r *http.Request) string {
+	var match mux.RouteMatch
+	if !routes.Match(r, &match) || match.Route == nil {
+		return ""
+	}
+	route, _ := match.Route.GetPathTemplate()
+	return route
+}
+
+// AccessLog guarda no contexto um logger com request ID, método, caminho e template da
+// rota, e registra status, latência e bytes ao fim de cada requisição. O template vem de
+// routes, para que requisições sem rota (404/405) também sejam registradas.
+func AccessLog(base *slog.Logger, routes *mux.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			route := routeTemplate(routes, r)
+			log := base.With(
+				slog.String("request_id", RequestIDFromContext(r.Context())),
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.String("route", route),
+			)
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r.WithContext(logger.WithContext(r.Context(), log)))
+
+			status := rec.statusCode()
+			level := slog.LevelInfo
+			switch {
+			case status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case status >= http.StatusBadRequest:
+				level = slog.LevelWarn
+			}
+			log.LogAttrs(r.Context(), level, "request completed",
+				slog.Int("status", status),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int("bytes", rec.bytes),
+			)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/pkg/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appErrors "fut-app/internal/errors"
+)
+
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var line map[string]any
+		require.NoError(t, dec.Decode(&line))
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func newAccessLogRouter(buf *bytes.Buffer) http.Handler {
+	base := slog.New(slog.NewJSONHandler(buf, nil))
+	r := mux.NewRouter()
+	r.Handle("/players/{id:[0-9]+}", AppHandler(func(w http.ResponseWriter, r *http.Request) error {
+		logger.FromContext(r.Context()).Info("inside handler")
+		_, _ = w.Write([]byte(`{"id":4}`))
+		return nil
+	})).Methods(http.MethodGet)
+	r.Handle("/players/{id:[0-9]+}", AppHandler(func(w http.ResponseWriter, r *http.Request) error {
+		return appErrors.ErrDatabase
+	})).Methods(http.MethodDelete)
+	return RequestID(AccessLog(base, r)(r))
+}
+
+func TestAccessLog_LogsCompletedRequest(t *testing.T) {
+	var buf bytes.Buffer
+	h := newAccessLogRouter(&buf)
+
+	req := httptest.NewRequest(http.MethodGet, "/players/4", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	lines := logLines(t, &buf)
+	require.Len(t, lines, 2)
+	// O logger do contexto, usado pelo handler, carrega os mesmos atributos do access log.
+	for _, line := range lines {
+		assert.Equal(t, "req-1", line["request_id"])
+		assert.Equal(t, "GET", line["method"])
+		assert.Equal(t, "/players/4", line["path"])
+		assert.Equal(t, "/players/{id:[0-9]+}", line["route"])
+	}
+	assert.Equal(t, "inside handler", lines[0]["msg"])
+
+	access := lines[1]
+	assert.Equal(t, "request completed", access["msg"])
+	assert.Equal(t, "INFO", access["level"])
+	assert.Equal(t, float64(http.StatusOK), access["status"])
+	assert.Equal(t, float64(len(`{"id":4}`)), access["bytes"])
+	assert.Contains(t, access, "latency")
+}
+
+func TestAccessLog_ErrorsLogAtErrorLevel(t *testing.T) {
+	var buf bytes.Buffer
+	h := newAccessLogRouter(&buf)
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, "/players/4", nil))
+
+	lines := logLines(t, &buf)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "request failed", lines[0]["msg"])
+	assert.NotEmpty(t, lines[0]["request_id"])
+	assert.Equal(t, "ERROR", lines[1]["level"])
+	assert.Equal(t, float64(http.StatusInternalServerError), lines[1]["status"])
+}
+
+func TestAccessLog_UnmatchedRoute(t *testing.T) {
+	var buf bytes.Buffer
+	h := newAccessLogRouter(&buf)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+	lines := logLines(t, &buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "", lines[0]["route"])
+	assert.Equal(t, "WARN", lines[0]["level"])
+	assert.Equal(t, float64(http.StatusNotFound), lines[0]["status"])
+}
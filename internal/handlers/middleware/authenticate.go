@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/logger"
+)
+
+// Authenticate exige um token de acesso no cabeçalho Authorization: Bearer. Os claims
+// verificados vão para o contexto (domain.PrincipalFromContext) e o logger da requisição
+// ganha account_id.
+func Authenticate(uc usecase.AuthenticateUseCase) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return AppHandler(func(w http.ResponseWriter, r *http.Request) error {
+			token, ok := bearerToken(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", domain.TokenTypeBearer)
+				return appErr.ErrUnauthorized
+			}
+
+			claims, err := uc.Execute(r.Context(), token)
+			if err != nil {
+				if errors.Is(err, appErr.ErrUnauthorized) {
+					w.Header().Set("WWW-Authenticate", domain.TokenTypeBearer)
+				}
+				return err
+			}
+
+			ctx := domain.WithPrincipal(r.Context(), *claims)
+			log := logger.FromContext(ctx).With(slog.Uint64("account_id", uint64(claims.AccountID)))
+			next.ServeHTTP(w, r.WithContext(logger.WithContext(ctx, log)))
+			return nil
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, domain.TokenTypeBearer) {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
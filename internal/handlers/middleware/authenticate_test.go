@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErrors "fut-app/internal/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type authenticateStub struct {
+	token string
+}
+
+func (s *authenticateStub) Execute(_ context.Context, token string) (*domain.TokenClaims, error) {
+	s.token = token
+	if token != "valid" {
+		return nil, appErrors.ErrUnauthorized
+	}
+	return &domain.TokenClaims{Principal: domain.Principal{AccountID: 1}, Kind: domain.AccessToken}, nil
+}
+
+func newAuthenticated(stub *authenticateStub, got *domain.TokenClaims) http.Handler {
+	return Authenticate(stub)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*got, _ = domain.PrincipalFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+func TestAuthenticate_PutsPrincipalInContext(t *testing.T) {
+	stub := &authenticateStub{}
+	var got domain.TokenClaims
+	h := newAuthenticated(stub, &got)
+
+	req := httptest.NewRequest(http.MethodGet, "/players", nil)
+	req.Header.Set("Authorization", "bearer valid")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "valid", stub.token)
+	assert.Equal(t, domain.Principal{AccountID: 1}, got.Principal)
+}
+
+func TestAuthenticate_Unauthorized(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+	}{
+		{"missing header", ""},
+		{"basic scheme", "Basic dXNlcjpwYXNz"},
+		{"empty token", "Bearer  "},
+		{"invalid token", "Bearer expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got domain.TokenClaims
+			h := newAuthenticated(&authenticateStub{}, &got)
+
+			req := httptest.NewRequest(http.MethodGet, "/players", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+			assert.Zero(t, got.AccountID)
+		})
+	}
+}
@@ -7,21 +7,19 @@ import (
 	"net/http"
 
 	appErr "fut-app/internal/errors"
+	"fut-app/pkg/logger"
 )
 
 type AppHandler func(w http.ResponseWriter, r *http.Request) error
 
 func (fn AppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if err := fn(w, r); err != nil {
-		logger := slog.Default()
+		// O logger da requisição já traz request ID, método, caminho e rota.
+		log := logger.FromContext(r.Context())
 
 		var ve *appErr.ValidationErrors
 		if errors.As(err, &ve) {
-			logger.Warn("validation failed",
-				slog.String("method", r.Method),
-				slog.String("path", r.URL.Path),
-				slog.Any("errors", ve),
-			)
+			log.Warn("validation failed", slog.Any("errors", ve))
 
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusBadRequest)
@@ -35,9 +33,7 @@ func (fn AppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 		status, httpErr := appErr.ToHTTPError(err)
 
-		logger.Error("request failed",
-			slog.String("method", r.Method),
-			slog.String("path", r.URL.Path),
+		log.Error("request failed",
 			slog.Int("status", status),
 			slog.String("error", err.Error()),
 		)
@@ -45,7 +41,7 @@ func (fn AppHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(status)
 		if encErr := json.NewEncoder(w).Encode(httpErr); encErr != nil {
-			logger.Error("failed to encode http error", slog.String("error", encErr.Error()))
+			log.Error("failed to encode http error", slog.String("error", encErr.Error()))
 		}
 	}
 }
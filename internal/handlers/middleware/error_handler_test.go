@@ -3,6 +3,7 @@ package middleware
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -46,6 +47,7 @@ func TestAppHandler_ServesHTTP_MapsKnownErrors(t *testing.T) {
 		{"invalid_data", appErrors.ErrInvalidData, http.StatusUnprocessableEntity},
 		{"unauthorized", appErrors.ErrUnauthorized, http.StatusUnauthorized},
 		{"forbidden", appErrors.ErrForbidden, http.StatusForbidden},
+		{"wrapped_forbidden", fmt.Errorf("%w: player requires matches:manage", appErrors.ErrForbidden), http.StatusForbidden},
 		{"database", appErrors.ErrDatabase, http.StatusInternalServerError},
 		{"internal", errors.New("other"), http.StatusInternalServerError},
 	}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/internal/usecase"
+	"fut-app/pkg/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// GroupScope resolve o {group} da rota no vínculo da conta com o grupo. O vínculo vai para o
+// contexto (domain.MembershipFromContext), de onde os repositórios tiram o grupo de cada
+// consulta, e o logger da requisição ganha group_id. Vem depois de Authenticate.
+func GroupScope(uc usecase.GetMembershipUseCase) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return AppHandler(func(w http.ResponseWriter, r *http.Request) error {
+			groupID, err := strconv.ParseUint(mux.Vars(r)["group"], 10, 64)
+			if err != nil || groupID == 0 {
+				return appErr.ErrNotFound
+			}
+
+			membership, err := uc.Execute(r.Context(), uint(groupID))
+			if err != nil {
+				return err
+			}
+
+			ctx := domain.WithMembership(r.Context(), *membership)
+			log := logger.FromContext(ctx).With(slog.Uint64("group_id", groupID))
+			next.ServeHTTP(w, r.WithContext(logger.WithContext(ctx, log)))
+			return nil
+		})
+	}
+}
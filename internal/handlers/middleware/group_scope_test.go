@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErrors "fut-app/internal/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type getMembershipStub struct{}
+
+func (getMembershipStub) Execute(_ context.Context, groupID uint) (*domain.Membership, error) {
+	if groupID != 1 {
+		return nil, appErrors.ErrNotFound
+	}
+	return &domain.Membership{GroupID: 1, AccountID: 7, PlayerID: 70, Role: domain.RolePlayer}, nil
+}
+
+func newGroupScoped(got *domain.Membership) http.Handler {
+	r := mux.NewRouter()
+	group := r.PathPrefix("/groups/{group:[0-9]+}").Subrouter()
+	group.Use(GroupScope(getMembershipStub{}))
+	group.HandleFunc("/players", func(w http.ResponseWriter, r *http.Request) {
+		*got, _ = domain.MembershipFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return r
+}
+
+func TestGroupScope_PutsMembershipInContext(t *testing.T) {
+	var got domain.Membership
+	rec := httptest.NewRecorder()
+	newGroupScoped(&got).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/groups/1/players", nil))
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, domain.Membership{GroupID: 1, AccountID: 7, PlayerID: 70, Role: domain.RolePlayer}, got)
+}
+
+func TestGroupScope_NotMember(t *testing.T) {
+	var got domain.Membership
+	rec := httptest.NewRecorder()
+	newGroupScoped(&got).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/groups/2/players", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Zero(t, got.GroupID)
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// RequestObserver recebe cada requisição concluída; metrics.Metrics implementa.
+type RequestObserver interface {
+	ObserveRequest(method, route string, status int, elapsed time.Duration)
+}
+
+// Metrics mede contagem e latência das requisições por template de rota e status.
+func Metrics(observer RequestObserver, routes *mux.Router) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			observer.ObserveRequest(r.Method, routeTemplate(routes, r), rec.statusCode(), time.Since(start))
+		})
+	}
+}
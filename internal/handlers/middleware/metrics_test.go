@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type observation struct {
+	method, route string
+	status        int
+}
+
+type recordingObserver struct {
+	observed []observation
+}
+
+func (o *recordingObserver) ObserveRequest(method, route string, status int, _ time.Duration) {
+	o.observed = append(o.observed, observation{method, route, status})
+}
+
+func TestMetrics_ObservesRouteTemplateAndStatus(t *testing.T) {
+	obs := &recordingObserver{}
+	r := mux.NewRouter()
+	r.HandleFunc("/players/{id:[0-9]+}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}).Methods(http.MethodPost)
+	h := Metrics(obs, r)(r)
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/players/4", nil))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nope/123", nil))
+
+	require.Len(t, obs.observed, 2)
+	assert.Equal(t, observation{http.MethodPost, "/players/{id:[0-9]+}", http.StatusCreated}, obs.observed[0])
+	// Sem rota, o caminho cru não vira label.
+	assert.Equal(t, observation{http.MethodGet, "", http.StatusNotFound}, obs.observed[1])
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID_GeneratesID(t *testing.T) {
+	var got string
+	h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = RequestIDFromContext(r.Context())
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/players", nil))
+
+	assert.Len(t, got, 32)
+	assert.Equal(t, got, rr.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_PropagatesIncomingID(t *testing.T) {
+	var got string
+	h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = RequestIDFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/players", nil)
+	req.Header.Set(RequestIDHeader, "lb-7f3a")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(t, "lb-7f3a", got)
+	assert.Equal(t, "lb-7f3a", rr.Header().Get(RequestIDHeader))
+}
+
+func TestRequestID_RejectsInvalidIncomingID(t *testing.T) {
+	for name, id := range map[string]string{
+		"control characters": "abc\x1bdef",
+		"spaces":             "a b",
+		"too long":           strings.Repeat("a", maxRequestIDLength+1),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			h := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = RequestIDFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/players", nil)
+			req.Header.Set(RequestIDHeader, id)
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.NotEqual(t, id, got)
+			assert.Len(t, got, 32)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	appErrors "fut-app/internal/errors"
+)
+
+func TestTimeout_SetsDeadline(t *testing.T) {
+	var deadline time.Time
+	h := Timeout(time.Second)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, _ = r.Context().Deadline()
+	}))
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+}
+
+func TestTimeout_ExpiredRequestReturns504(t *testing.T) {
+	h := Timeout(time.Millisecond)(AppHandler(func(w http.ResponseWriter, r *http.Request) error {
+		<-r.Context().Done()
+		return r.Context().Err()
+	}))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/players", nil))
+
+	assert.Equal(t, http.StatusGatewayTimeout, rr.Code)
+	var body appErrors.HTTPError
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(t, "timeout", body.Code)
+}
+
+func TestAppHandler_ClientCanceledReturns499(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h := AppHandler(func(w http.ResponseWriter, r *http.Request) error {
+		return r.Context().Err()
+	})
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/players", nil).WithContext(ctx))
+
+	assert.Equal(t, appErrors.StatusClientClosedRequest, rr.Code)
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"fut-app/pkg/logger"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing abre um span por requisição com o otelmux, nomeado pelo método e template da
+// rota, e acrescenta trace_id e span_id ao logger da requisição. Como roda dentro do
+// router (r.Use), requisições sem rota não geram spans.
+func Tracing(service string) mux.MiddlewareFunc {
+	traced := otelmux.Middleware(service, otelmux.WithSpanNameFormatter(func(route string, r *http.Request) string {
+		return r.Method + " " + route
+	}))
+	return func(next http.Handler) http.Handler {
+		return traced(traceLogger(next))
+	}
+}
+
+func traceLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		span := trace.SpanFromContext(ctx)
+		sc := span.SpanContext()
+		if !sc.IsValid() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if id := RequestIDFromContext(ctx); id != "" {
+			span.SetAttributes(attribute.String("http.request_id", id))
+		}
+		log := logger.FromContext(ctx).With(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+		next.ServeHTTP(w, r.WithContext(logger.WithContext(ctx, log)))
+	})
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/pkg/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing_SpanPerRouteAndTraceIDInLogs(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	var buf bytes.Buffer
+	r := mux.NewRouter()
+	r.Use(Tracing("fut-app"))
+	r.HandleFunc("/players/{id:[0-9]+}", func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).Info("inside handler")
+	}).Methods(http.MethodGet)
+	h := RequestID(AccessLog(slog.New(slog.NewJSONHandler(&buf, nil)), r)(r))
+
+	req := httptest.NewRequest(http.MethodGet, "/players/4", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /players/{id:[0-9]+}", span.Name())
+
+	lines := logLines(t, &buf)
+	require.Len(t, lines, 2)
+	assert.Equal(t, span.SpanContext().TraceID().String(), lines[0]["trace_id"])
+	assert.Equal(t, span.SpanContext().SpanID().String(), lines[0]["span_id"])
+	assert.Equal(t, "req-1", lines[0]["request_id"])
+
+	var requestID string
+	for _, kv := range span.Attributes() {
+		if kv.Key == "http.request_id" {
+			requestID = kv.Value.AsString()
+		}
+	}
+	assert.Equal(t, "req-1", requestID)
+}
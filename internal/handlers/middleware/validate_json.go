@@ -3,30 +3,15 @@ package middleware
 import (
 	"encoding/json"
 	"net/http"
-	"sync"
 
 	"fut-app/internal/errors"
 
 	"github.com/go-playground/validator/v10"
 )
 
-var (
-	validate       = validator.New()
-	registerCustom sync.Once
-)
+var validate = validator.New()
 
 func ValidateJSON[T any](next func(http.ResponseWriter, *http.Request, T) error) AppHandler {
-	registerCustom.Do(func() {
-		if err := validate.RegisterValidation("statslen", func(fl validator.FieldLevel) bool {
-			if m, ok := fl.Field().Interface().(map[string]interface{}); ok {
-				return len(m) == 6
-			}
-			return false
-		}); err != nil {
-			panic(err)
-		}
-	})
-
 	return func(w http.ResponseWriter, r *http.Request) error {
 		var body T
 		dec := json.NewDecoder(r.Body)
@@ -14,7 +14,7 @@ import (
 
 type sampleDTO struct {
 	Name  string                 `json:"name" validate:"required"`
-	Stats map[string]interface{} `json:"stats" validate:"required,statslen"`
+	Stats map[string]interface{} `json:"stats" validate:"required"`
 }
 
 func TestValidateJSON_Success(t *testing.T) {
@@ -57,7 +57,7 @@ func TestValidateJSON_InvalidBody(t *testing.T) {
 }
 
 func TestValidateJSON_ValidationFails(t *testing.T) {
-	dto := sampleDTO{Name: "", Stats: map[string]interface{}{"a": 1, "b": 2}} // invalid: required + statslen
+	dto := sampleDTO{Name: "", Stats: map[string]interface{}{"a": 1, "b": 2}} // invalid: name is required
 	b, _ := json.Marshal(dto)
 
 	handler := ValidateJSON[sampleDTO](func(w http.ResponseWriter, r *http.Request, d sampleDTO) error { return nil })
@@ -100,46 +100,6 @@ func TestValidateJSON_UnknownFields(t *testing.T) {
 	assert.Equal(t, appErrors.ErrInvalidData, err)
 }
 
-func TestValidateJSON_CustomValidation_statslen(t *testing.T) {
-	tests := []struct {
-		name      string
-		statsLen  int
-		shouldErr bool
-	}{
-		{"exactly 6 stats - valid", 6, false},
-		{"less than 6 stats - invalid", 3, true},
-		{"more than 6 stats - invalid", 8, true},
-		{"empty stats - invalid", 0, true},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			stats := make(map[string]interface{})
-			for i := 0; i < tt.statsLen; i++ {
-				stats[string(rune('a'+i))] = i + 1
-			}
-
-			dto := sampleDTO{
-				Name:  "Valid Name",
-				Stats: stats,
-			}
-			b, _ := json.Marshal(dto)
-
-			handler := ValidateJSON[sampleDTO](func(w http.ResponseWriter, r *http.Request, d sampleDTO) error { return nil })
-			rr := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(b))
-			err := handler(rr, req)
-
-			if tt.shouldErr {
-				assert.Error(t, err)
-				assert.Equal(t, appErrors.ErrInvalidData, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
 type anotherDTO struct {
 	Email string `json:"email" validate:"required,email"`
 	Age   int    `json:"age" validate:"required,min=0,max=120"`
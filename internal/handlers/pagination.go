@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+)
+
+// pageRequest lê page, page_size, sort e search da query string; filters são os demais
+// parâmetros que a listagem aceita como filtro.
+func pageRequest(r *http.Request, filters ...string) (domain.PageRequest, error) {
+	query := r.URL.Query()
+	var errs errors.ValidationErrors
+
+	req := domain.PageRequest{
+		Page:     positiveParam(query.Get("page"), "page", &errs),
+		PageSize: positiveParam(query.Get("page_size"), "page_size", &errs),
+		Sort:     query.Get("sort"),
+		Search:   query.Get("search"),
+		Filters:  map[string]string{},
+	}
+	for _, name := range filters {
+		if value := query.Get(name); value != "" {
+			req.Filters[name] = value
+		}
+	}
+
+	if errs.HasErrors() {
+		return domain.PageRequest{}, &errs
+	}
+	return req, nil
+}
+
+func positiveParam(raw, name string, errs *errors.ValidationErrors) int {
+	if raw == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 {
+		errs.Append(name, fmt.Sprintf("%s must be a positive integer", name))
+		return 0
+	}
+	return n
+}
+
+// respondPage escreve a página com os metadados no corpo e os links de navegação no
+// header Link (RFC 8288), mantendo os demais parâmetros da requisição.
+func respondPage[T any](w http.ResponseWriter, r *http.Request, page *domain.Page[T]) error {
+	link := func(n int, rel string) string {
+		query := r.URL.Query()
+		query.Set("page", strconv.Itoa(n))
+		query.Set("page_size", strconv.Itoa(page.PageSize))
+		return fmt.Sprintf(`<%s?%s>; rel="%s"`, r.URL.Path, query.Encode(), rel)
+	}
+
+	links := []string{link(1, "first")}
+	if page.HasPrev() {
+		links = append(links, link(page.Page-1, "prev"))
+	}
+	if page.HasNext() {
+		links = append(links, link(page.Page+1, "next"))
+	}
+	links = append(links, link(page.TotalPages, "last"))
+	w.Header().Set("Link", strings.Join(links, ", "))
+
+	return httprespond.JSON(w, http.StatusOK, dto.NewPageResponse(*page))
+}
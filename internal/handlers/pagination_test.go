@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErrors "fut-app/internal/errors"
+)
+
+func TestPageRequest_ReadsFilters(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/players?position=ST&team=azul&page=3", nil)
+
+	req, err := pageRequest(r, "position")
+	if err != nil {
+		t.Fatalf("pageRequest() error = %v", err)
+	}
+	if req.Page != 3 || req.PageSize != 0 || len(req.Filters) != 1 || req.Filters["position"] != "ST" {
+		t.Errorf("pageRequest() = %+v", req)
+	}
+}
+
+func TestPageRequest_InvalidNumbers(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/players?page=abc&page_size=-1", nil)
+
+	_, err := pageRequest(r)
+	var ve *appErrors.ValidationErrors
+	if !errors.As(err, &ve) || len(*ve) != 2 || (*ve)[0].Field != "page" || (*ve)[1].Field != "page_size" {
+		t.Fatalf("pageRequest() error = %v, want page and page_size errors", err)
+	}
+}
+
+func TestRespondPage_LinkHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		page int
+		want string
+	}{
+		{"first page", 1, `</players?page=1&page_size=10&sort=name>; rel="first", </players?page=2&page_size=10&sort=name>; rel="next", </players?page=3&page_size=10&sort=name>; rel="last"`},
+		{"middle page", 2, `</players?page=1&page_size=10&sort=name>; rel="first", </players?page=1&page_size=10&sort=name>; rel="prev", </players?page=3&page_size=10&sort=name>; rel="next", </players?page=3&page_size=10&sort=name>; rel="last"`},
+		{"last page", 3, `</players?page=1&page_size=10&sort=name>; rel="first", </players?page=2&page_size=10&sort=name>; rel="prev", </players?page=3&page_size=10&sort=name>; rel="last"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := domain.NewPage([]string{"a"}, 25, tt.page, 10)
+			rr := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/players?sort=name", nil)
+
+			if err := respondPage(rr, r, &page); err != nil {
+				t.Fatalf("respondPage() error = %v", err)
+			}
+			if got := rr.Header().Get("Link"); got != tt.want {
+				t.Errorf("Link = %s\nwant   %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"fut-app/internal/errors"
+
+	"github.com/gorilla/mux"
+)
+
+// pathID lê o parâmetro {id} da rota.
+func pathID(r *http.Request) (uint, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil || id == 0 {
+		return 0, errors.ErrBadRequest
+	}
+	return uint(id), nil
+}
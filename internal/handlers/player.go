@@ -11,66 +11,84 @@ import (
 )
 
 type PlayerHandler struct {
-	useCase usecase.RegisterPlayerUseCase
+	useCase      usecase.RegisterPlayerUseCase
+	getPlayer    usecase.GetPlayerUseCase
+	listPlayers  usecase.ListPlayersUseCase
+	updatePlayer usecase.UpdatePlayerUseCase
+	deletePlayer usecase.DeletePlayerUseCase
 }
 
-func NewPlayerHandler(p usecase.RegisterPlayerUseCase) *PlayerHandler {
+func NewPlayerHandler(
+	p usecase.RegisterPlayerUseCase,
+	get usecase.GetPlayerUseCase,
+	list usecase.ListPlayersUseCase,
+	update usecase.UpdatePlayerUseCase,
+	del usecase.DeletePlayerUseCase,
+) *PlayerHandler {
 	return &PlayerHandler{
-		useCase: p,
+		useCase:      p,
+		getPlayer:    get,
+		listPlayers:  list,
+		updatePlayer: update,
+		deletePlayer: del,
 	}
 }
 
 func (h *PlayerHandler) CreatePlayer(w http.ResponseWriter, r *http.Request, p dto.PlayerDTO) error {
-	newPlayer, err := h.useCase.Execute(p.ToDomain())
+	newPlayer, err := h.useCase.Execute(r.Context(), p.ToDomain())
 	if err != nil {
 		return err
 	}
 	return httprespond.JSON(w, http.StatusCreated, newPlayer)
 }
 
-//func (h *PlayerHandler) GetPlayerByID(w http.ResponseWriter, r *http.Request) error {
-//	vars := mux.Vars(r)
-//	id, err := strconv.Atoi(vars["id"])
-//	if err != nil {
-//		return errors.ErrBadRequest
-//	}
-//
-//	player, err := h.Service.GetPlayerByID(uint(id))
-//	if err != nil {
-//		return err
-//	}
-//
-//	return httprespond.JSON(w, http.StatusOK, player)
-//}
+func (h *PlayerHandler) GetPlayerByID(w http.ResponseWriter, r *http.Request) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	player, err := h.getPlayer.Execute(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, player)
+}
+
+// GetPlayers aceita search, position, sort (ex.: -overall,name), page e page_size.
+func (h *PlayerHandler) GetPlayers(w http.ResponseWriter, r *http.Request) error {
+	req, err := pageRequest(r, "position")
+	if err != nil {
+		return err
+	}
+	players, err := h.listPlayers.Execute(r.Context(), req)
+	if err != nil {
+		return err
+	}
+	return respondPage(w, r, players)
+}
 
-//func (h *PlayerHandler) GetPlayers(w http.ResponseWriter, r *http.Request) error {
-//	players := h.Service.GetAllPlayers()
-//	return httprespond.JSON(w, http.StatusOK, players)
-//}
+func (h *PlayerHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request, p dto.PlayerUpdateDTO) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
 
-//func (h *PlayerHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request, p dto.PlayerDTO) error {
-//	vars := mux.Vars(r)
-//	id, err := strconv.Atoi(vars["id"])
-//	if err != nil {
-//		return errors.ErrBadRequest
-//	}
-//	var player models.Player
-//
-//	if err = h.Service.UpdatePlayer(p.ToDomain(), uint(id)); err != nil {
-//		return err
-//	}
-//	return httprespond.JSON(w, http.StatusOK, player)
-//}
+	player, err := h.updatePlayer.Execute(r.Context(), id, p.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, player)
+}
 
-//func (h *PlayerHandler) DeletePlayer(w http.ResponseWriter, r *http.Request) error {
-//	vars := mux.Vars(r)
-//	id, err := strconv.Atoi(vars["id"])
-//	if err != nil {
-//		return errors.ErrBadRequest
-//	}
-//
-//	if err = h.Service.DeletePlayer(uint(id)); err != nil {
-//		return err
-//	}
-//	return httprespond.JSON(w, http.StatusNoContent, nil)
-//}
+func (h *PlayerHandler) DeletePlayer(w http.ResponseWriter, r *http.Request) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	if err := h.deletePlayer.Execute(r.Context(), id); err != nil {
+		return err
+	}
+	return httprespond.NoContent(w)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type PlayerCardHandler struct {
+	getPlayerCard usecase.GetPlayerCardUseCase
+}
+
+func NewPlayerCardHandler(get usecase.GetPlayerCardUseCase) *PlayerCardHandler {
+	return &PlayerCardHandler{
+		getPlayerCard: get,
+	}
+}
+
+func (h *PlayerCardHandler) GetPlayerCard(w http.ResponseWriter, r *http.Request) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	card, err := h.getPlayerCard.Execute(r.Context(), id)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, card)
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErrors "fut-app/internal/errors"
+
+	"github.com/gorilla/mux"
+)
+
+type stubGetPlayerCardUseCase struct {
+	executeFn func(uint) (*domain.PlayerCard, error)
+}
+
+func (s *stubGetPlayerCardUseCase) Execute(_ context.Context, id uint) (*domain.PlayerCard, error) {
+	return s.executeFn(id)
+}
+
+func TestPlayerCardHandler_GetPlayerCard_Success(t *testing.T) {
+	uc := &stubGetPlayerCardUseCase{
+		executeFn: func(id uint) (*domain.PlayerCard, error) {
+			return &domain.PlayerCard{PlayerID: id, Name: "Zico", Overall: 88}, nil
+		},
+	}
+
+	h := NewPlayerCardHandler(uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/players/4/card", nil), map[string]string{"id": "4"})
+
+	if err := h.GetPlayerCard(rr, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got domain.PlayerCard
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if got.PlayerID != 4 || got.Overall != 88 {
+		t.Fatalf("unexpected body: %+v", got)
+	}
+}
+
+func TestPlayerCardHandler_GetPlayerCard_NotFound(t *testing.T) {
+	uc := &stubGetPlayerCardUseCase{
+		executeFn: func(uint) (*domain.PlayerCard, error) { return nil, appErrors.ErrNotFound },
+	}
+
+	h := NewPlayerCardHandler(uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/players/4/card", nil), map[string]string{"id": "4"})
+
+	if err := h.GetPlayerCard(rr, req); err != appErrors.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
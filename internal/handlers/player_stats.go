@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type PlayerStatsHandler struct {
+	migrateStats usecase.MigratePlayerStatsUseCase
+}
+
+func NewPlayerStatsHandler(migrate usecase.MigratePlayerStatsUseCase) *PlayerStatsHandler {
+	return &PlayerStatsHandler{
+		migrateStats: migrate,
+	}
+}
+
+func (h *PlayerStatsHandler) MigrateStats(w http.ResponseWriter, r *http.Request, d dto.MigratePlayerStatsDTO) error {
+	report, err := h.migrateStats.Execute(r.Context(), d.DryRun)
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/handlers/dto"
+)
+
+type stubMigratePlayerStatsUseCase struct {
+	executeFn func(bool) (*domain.StatsMigrationReport, error)
+}
+
+func (s *stubMigratePlayerStatsUseCase) Execute(_ context.Context, dryRun bool) (*domain.StatsMigrationReport, error) {
+	return s.executeFn(dryRun)
+}
+
+func TestPlayerStatsHandler_MigrateStats_Success(t *testing.T) {
+	uc := &stubMigratePlayerStatsUseCase{
+		executeFn: func(dryRun bool) (*domain.StatsMigrationReport, error) {
+			if !dryRun {
+				t.Fatal("expected dry run")
+			}
+			return &domain.StatsMigrationReport{DryRun: true, Checked: 3, Migrated: []uint{2}}, nil
+		},
+	}
+
+	h := NewPlayerStatsHandler(uc)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/players/stats/migrate", nil)
+
+	if err := h.MigrateStats(rr, req, dto.MigratePlayerStatsDTO{DryRun: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var got domain.StatsMigrationReport
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if got.Checked != 3 || len(got.Migrated) != 1 || got.Migrated[0] != 2 {
+		t.Fatalf("unexpected body: %+v", got)
+	}
+}
+
+func TestPlayerStatsHandler_MigrateStats_Error(t *testing.T) {
+	uc := &stubMigratePlayerStatsUseCase{
+		executeFn: func(bool) (*domain.StatsMigrationReport, error) { return nil, errors.New("db down") },
+	}
+
+	h := NewPlayerStatsHandler(uc)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/players/stats/migrate", nil)
+
+	if err := h.MigrateStats(rr, req, dto.MigratePlayerStatsDTO{}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
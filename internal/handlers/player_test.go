@@ -1,14 +1,19 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"fut-app/internal/domain"
 	appErrors "fut-app/internal/errors"
 	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
 )
 
 // stubRegisterPlayerUseCase is a simple stub implementing RegisterPlayerUseCase
@@ -16,7 +21,7 @@ type stubRegisterPlayerUseCase struct {
 	executeFn func(domain.Player) (*domain.Player, error)
 }
 
-func (s *stubRegisterPlayerUseCase) Execute(p domain.Player) (*domain.Player, error) {
+func (s *stubRegisterPlayerUseCase) Execute(_ context.Context, p domain.Player) (*domain.Player, error) {
 	return s.executeFn(p)
 }
 
@@ -24,8 +29,8 @@ func TestPlayerHandler_CreatePlayer_Success(t *testing.T) {
 	// Arrange
 	input := dto.PlayerDTO{
 		Name: "Neymar Jr",
-		Stats: map[string]interface{}{
-			"pace": 90, "shooting": 85, "passing": 86, "dribbling": 93, "defending": 32, "physical": 58,
+		Stats: dto.StatsDTO{
+			Finishing: 85, Passing: 86, Speed: 90, Defense: 45, Stamina: 58, Highlight: 93,
 		},
 		Position: []string{"LW"},
 	}
@@ -33,7 +38,7 @@ func TestPlayerHandler_CreatePlayer_Success(t *testing.T) {
 	expected := &domain.Player{
 		ID:       1,
 		Name:     input.Name,
-		Stats:    input.Stats,
+		Stats:    input.Stats.ToDomain(),
 		Position: input.Position,
 	}
 
@@ -47,7 +52,7 @@ func TestPlayerHandler_CreatePlayer_Success(t *testing.T) {
 		},
 	}
 
-	h := NewPlayerHandler(uc)
+	h := NewPlayerHandler(uc, nil, nil, nil, nil)
 	rr := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPost, "/players", nil)
 
@@ -74,8 +79,8 @@ func TestPlayerHandler_CreatePlayer_Success(t *testing.T) {
 	if len(got.Position) != len(expected.Position) || got.Position[0] != expected.Position[0] {
 		t.Fatalf("unexpected positions: %+v", got.Position)
 	}
-	if len(got.Stats) != len(expected.Stats) {
-		t.Fatalf("unexpected stats length: %d", len(got.Stats))
+	if got.Stats != expected.Stats {
+		t.Fatalf("unexpected stats: %+v", got.Stats)
 	}
 }
 
@@ -83,7 +88,7 @@ func TestPlayerHandler_CreatePlayer_ErrorFromUseCase(t *testing.T) {
 	// Arrange
 	input := dto.PlayerDTO{
 		Name:     "",
-		Stats:    map[string]interface{}{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6},
+		Stats:    dto.StatsDTO{Finishing: 70, Passing: 80, Speed: 60, Defense: 65, Stamina: 75, Highlight: 85},
 		Position: []string{"LW"},
 	}
 
@@ -93,7 +98,7 @@ func TestPlayerHandler_CreatePlayer_ErrorFromUseCase(t *testing.T) {
 		},
 	}
 
-	h := NewPlayerHandler(uc)
+	h := NewPlayerHandler(uc, nil, nil, nil, nil)
 	rr := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPost, "/players", nil)
 
@@ -112,3 +117,200 @@ func TestPlayerHandler_CreatePlayer_ErrorFromUseCase(t *testing.T) {
 		t.Fatalf("expected empty body on error, got %q", rr.Body.String())
 	}
 }
+
+type stubGetPlayerUseCase struct {
+	executeFn func(uint) (*domain.Player, error)
+}
+
+func (s *stubGetPlayerUseCase) Execute(_ context.Context, id uint) (*domain.Player, error) {
+	return s.executeFn(id)
+}
+
+type stubListPlayersUseCase struct {
+	executeFn func(domain.PageRequest) (*domain.Page[domain.Player], error)
+}
+
+func (s *stubListPlayersUseCase) Execute(_ context.Context, req domain.PageRequest) (*domain.Page[domain.Player], error) {
+	return s.executeFn(req)
+}
+
+type stubUpdatePlayerUseCase struct {
+	executeFn func(uint, domain.PlayerUpdate) (*domain.Player, error)
+}
+
+func (s *stubUpdatePlayerUseCase) Execute(_ context.Context, id uint, u domain.PlayerUpdate) (*domain.Player, error) {
+	return s.executeFn(id, u)
+}
+
+type stubDeletePlayerUseCase struct {
+	executeFn func(uint) error
+}
+
+func (s *stubDeletePlayerUseCase) Execute(_ context.Context, id uint) error {
+	return s.executeFn(id)
+}
+
+func TestPlayerHandler_GetPlayerByID_Success(t *testing.T) {
+	uc := &stubGetPlayerUseCase{
+		executeFn: func(id uint) (*domain.Player, error) {
+			if id != 10 {
+				t.Fatalf("expected id 10, got %d", id)
+			}
+			return &domain.Player{ID: id, Name: "Zico"}, nil
+		},
+	}
+
+	h := NewPlayerHandler(nil, uc, nil, nil, nil)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/players/10", nil), map[string]string{"id": "10"})
+
+	if err := h.GetPlayerByID(rr, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var got domain.Player
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if got.ID != 10 || got.Name != "Zico" {
+		t.Fatalf("unexpected body: %+v", got)
+	}
+}
+
+func TestPlayerHandler_GetPlayerByID_InvalidID(t *testing.T) {
+	h := NewPlayerHandler(nil, nil, nil, nil, nil)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/players/abc", nil), map[string]string{"id": "abc"})
+
+	if err := h.GetPlayerByID(rr, req); err != appErrors.ErrBadRequest {
+		t.Fatalf("expected ErrBadRequest, got %v", err)
+	}
+}
+
+func TestPlayerHandler_GetPlayerByID_NotFound(t *testing.T) {
+	uc := &stubGetPlayerUseCase{
+		executeFn: func(uint) (*domain.Player, error) { return nil, appErrors.ErrNotFound },
+	}
+
+	h := NewPlayerHandler(nil, uc, nil, nil, nil)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/players/1", nil), map[string]string{"id": "1"})
+
+	if err := h.GetPlayerByID(rr, req); err != appErrors.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestPlayerHandler_GetPlayers_Success(t *testing.T) {
+	uc := &stubListPlayersUseCase{
+		executeFn: func(req domain.PageRequest) (*domain.Page[domain.Player], error) {
+			if req.Search != "zi" || req.Sort != "-overall" || req.Filters["position"] != "CM" || req.Page != 2 || req.PageSize != 2 {
+				t.Fatalf("unexpected page request: %+v", req)
+			}
+			page := domain.NewPage([]domain.Player{{ID: 1, Name: "Zico"}, {ID: 2, Name: "Sócrates"}}, 5, req.Page, req.PageSize)
+			return &page, nil
+		},
+	}
+
+	h := NewPlayerHandler(nil, nil, uc, nil, nil)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/players?search=zi&position=CM&sort=-overall&page=2&page_size=2", nil)
+
+	if err := h.GetPlayers(rr, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got dto.PageResponse[domain.Player]
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if len(got.Data) != 2 {
+		t.Fatalf("expected 2 players, got %d", len(got.Data))
+	}
+	if got.Pagination != (dto.PaginationDTO{Page: 2, PageSize: 2, Total: 5, TotalPages: 3}) {
+		t.Fatalf("unexpected pagination: %+v", got.Pagination)
+	}
+	if link := rr.Header().Get("Link"); !strings.Contains(link, `rel="next"`) || !strings.Contains(link, "position=CM") {
+		t.Fatalf("unexpected Link header: %q", link)
+	}
+}
+
+func TestPlayerHandler_GetPlayers_InvalidPage(t *testing.T) {
+	h := NewPlayerHandler(nil, nil, &stubListPlayersUseCase{}, nil, nil)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/players?page=0", nil)
+
+	var ve *appErrors.ValidationErrors
+	if err := h.GetPlayers(rr, req); !errors.As(err, &ve) || (*ve)[0].Field != "page" {
+		t.Fatalf("expected page validation error, got %v", err)
+	}
+}
+
+func TestPlayerHandler_UpdatePlayer_Success(t *testing.T) {
+	input := dto.PlayerUpdateDTO{
+		Name:     "Sócrates",
+		Stats:    &dto.StatsDTO{Finishing: 70, Passing: 80, Speed: 60, Defense: 65, Stamina: 75, Highlight: 85},
+		Position: []string{"CM"},
+	}
+
+	uc := &stubUpdatePlayerUseCase{
+		executeFn: func(id uint, u domain.PlayerUpdate) (*domain.Player, error) {
+			if id != 5 {
+				t.Fatalf("expected id 5, got %d", id)
+			}
+			if u.Stats == nil || u.Stats.Highlight != 85 {
+				t.Fatalf("unexpected stats: %+v", u.Stats)
+			}
+			return &domain.Player{ID: id, Name: u.Name, Stats: *u.Stats, Position: u.Position}, nil
+		},
+	}
+
+	h := NewPlayerHandler(nil, nil, nil, uc, nil)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, "/players/5", nil), map[string]string{"id": "5"})
+
+	if err := h.UpdatePlayer(rr, req, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	var got domain.Player
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if got.ID != 5 || got.Name != input.Name {
+		t.Fatalf("unexpected body: %+v", got)
+	}
+}
+
+func TestPlayerHandler_DeletePlayer_Success(t *testing.T) {
+	var deleted uint
+	uc := &stubDeletePlayerUseCase{
+		executeFn: func(id uint) error {
+			deleted = id
+			return nil
+		},
+	}
+
+	h := NewPlayerHandler(nil, nil, nil, nil, uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/players/8", nil), map[string]string{"id": "8"})
+
+	if err := h.DeletePlayer(rr, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, rr.Code)
+	}
+	if deleted != 8 {
+		t.Fatalf("expected id 8 to be deleted, got %d", deleted)
+	}
+	if rr.Body.Len() != 0 {
+		t.Fatalf("expected empty body, got %q", rr.Body.String())
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type PositionHandler struct {
+	createPosition usecase.CreatePositionUseCase
+	listPositions  usecase.ListPositionsUseCase
+	updatePosition usecase.UpdatePositionUseCase
+	deletePosition usecase.DeletePositionUseCase
+}
+
+func NewPositionHandler(
+	create usecase.CreatePositionUseCase,
+	list usecase.ListPositionsUseCase,
+	update usecase.UpdatePositionUseCase,
+	del usecase.DeletePositionUseCase,
+) *PositionHandler {
+	return &PositionHandler{
+		createPosition: create,
+		listPositions:  list,
+		updatePosition: update,
+		deletePosition: del,
+	}
+}
+
+func (h *PositionHandler) CreatePosition(w http.ResponseWriter, r *http.Request, p dto.PositionDTO) error {
+	position, err := h.createPosition.Execute(r.Context(), p.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusCreated, position)
+}
+
+func (h *PositionHandler) GetPositions(w http.ResponseWriter, r *http.Request) error {
+	positions, err := h.listPositions.Execute(r.Context())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, positions)
+}
+
+func (h *PositionHandler) UpdatePosition(w http.ResponseWriter, r *http.Request, p dto.PositionPatchDTO) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	position, err := h.updatePosition.Execute(r.Context(), id, p.ToDomain())
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, position)
+}
+
+func (h *PositionHandler) DeletePosition(w http.ResponseWriter, r *http.Request) error {
+	id, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	if err := h.deletePosition.Execute(r.Context(), id); err != nil {
+		return err
+	}
+	return httprespond.NoContent(w)
+}
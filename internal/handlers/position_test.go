@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	appErrors "fut-app/internal/errors"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubCreatePositionUseCase struct {
+	executeFn func(domain.Position) (*domain.Position, error)
+}
+
+func (s *stubCreatePositionUseCase) Execute(_ context.Context, p domain.Position) (*domain.Position, error) {
+	return s.executeFn(p)
+}
+
+type stubListPositionsUseCase struct {
+	positions []domain.Position
+}
+
+func (s *stubListPositionsUseCase) Execute(_ context.Context) ([]domain.Position, error) {
+	return s.positions, nil
+}
+
+type stubUpdatePositionUseCase struct {
+	executeFn func(uint, domain.PositionPatch) (*domain.Position, error)
+}
+
+func (s *stubUpdatePositionUseCase) Execute(_ context.Context, id uint, patch domain.PositionPatch) (*domain.Position, error) {
+	return s.executeFn(id, patch)
+}
+
+type stubDeletePositionUseCase struct {
+	err error
+}
+
+func (s *stubDeletePositionUseCase) Execute(context.Context, uint) error {
+	return s.err
+}
+
+func TestPositionHandler_CreatePosition_Success(t *testing.T) {
+	uc := &stubCreatePositionUseCase{
+		executeFn: func(p domain.Position) (*domain.Position, error) {
+			if p.Abbreviation != "LIB" || p.Line != domain.LineDefense {
+				t.Fatalf("unexpected position: %+v", p)
+			}
+			p.ID = 11
+			return &p, nil
+		},
+	}
+
+	h := NewPositionHandler(uc, nil, nil, nil)
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/positions", nil)
+
+	if err := h.CreatePosition(rr, req, dto.PositionDTO{Name: "Líbero", Abbreviation: "lib", Line: "defense"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, rr.Code)
+	}
+
+	var got domain.Position
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if got.ID != 11 || got.Name != "Líbero" {
+		t.Fatalf("unexpected body: %+v", got)
+	}
+}
+
+func TestPositionHandler_GetPositions(t *testing.T) {
+	uc := &stubListPositionsUseCase{positions: domain.StandardPositions}
+
+	h := NewPositionHandler(nil, uc, nil, nil)
+	rr := httptest.NewRecorder()
+
+	if err := h.GetPositions(rr, httptest.NewRequest(http.MethodGet, "/positions", nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []domain.Position
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("invalid json response: %v", err)
+	}
+	if len(got) != len(domain.StandardPositions) {
+		t.Fatalf("expected %d positions, got %d", len(domain.StandardPositions), len(got))
+	}
+}
+
+func TestPositionHandler_UpdatePosition_Rename(t *testing.T) {
+	uc := &stubUpdatePositionUseCase{
+		executeFn: func(id uint, patch domain.PositionPatch) (*domain.Position, error) {
+			if id != 3 || patch.Name == nil || *patch.Name != "Beque" || patch.Line != nil {
+				t.Fatalf("unexpected patch for %d: %+v", id, patch)
+			}
+			return &domain.Position{ID: id, Name: *patch.Name, Abbreviation: "CB", Line: domain.LineDefense}, nil
+		},
+	}
+
+	h := NewPositionHandler(nil, nil, uc, nil)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPatch, "/positions/3", nil), map[string]string{"id": "3"})
+	name := "Beque"
+
+	if err := h.UpdatePosition(rr, req, dto.PositionPatchDTO{Name: &name}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+}
+
+func TestPositionHandler_DeletePosition(t *testing.T) {
+	h := NewPositionHandler(nil, nil, nil, &stubDeletePositionUseCase{})
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/positions/3", nil), map[string]string{"id": "3"})
+
+	if err := h.DeletePosition(rr, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, rr.Code)
+	}
+}
+
+func TestPositionHandler_DeletePosition_NotFound(t *testing.T) {
+	h := NewPositionHandler(nil, nil, nil, &stubDeletePositionUseCase{err: appErrors.ErrNotFound})
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/positions/3", nil), map[string]string{"id": "3"})
+
+	if err := h.DeletePosition(rr, req); err != appErrors.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
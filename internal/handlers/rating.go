@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type RatingHandler struct {
+	submitRatings usecase.SubmitRatingsUseCase
+}
+
+func NewRatingHandler(submit usecase.SubmitRatingsUseCase) *RatingHandler {
+	return &RatingHandler{
+		submitRatings: submit,
+	}
+}
+
+func (h *RatingHandler) SubmitRatings(w http.ResponseWriter, r *http.Request, s dto.RatingSubmissionDTO) error {
+	matchID, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	ratings, err := h.submitRatings.Execute(r.Context(), s.ToDomain(matchID))
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusCreated, ratings)
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubSubmitRatingsUseCase struct {
+	executeFn func(domain.RatingSubmission) ([]domain.Rating, error)
+}
+
+func (s *stubSubmitRatingsUseCase) Execute(_ context.Context, sub domain.RatingSubmission) ([]domain.Rating, error) {
+	return s.executeFn(sub)
+}
+
+func TestRatingHandler_SubmitRatings_Success(t *testing.T) {
+	input := dto.RatingSubmissionDTO{
+		RaterID: 1,
+		Ratings: []dto.RatingDTO{{PlayerID: 2, Finishing: 70, Passing: 71, Speed: 72, Defense: 73, Stamina: 74, Highlight: 75}},
+	}
+
+	uc := &stubSubmitRatingsUseCase{
+		executeFn: func(sub domain.RatingSubmission) ([]domain.Rating, error) {
+			if sub.MatchID != 12 || sub.RaterID != 1 {
+				t.Fatalf("unexpected submission: %+v", sub)
+			}
+			if sub.Ratings[0].RatedPlayerID != 2 || sub.Ratings[0].Highlight != 75 {
+				t.Fatalf("unexpected rating: %+v", sub.Ratings[0])
+			}
+			return sub.Ratings, nil
+		},
+	}
+
+	h := NewRatingHandler(uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/matches/12/ratings", nil), map[string]string{"id": "12"})
+
+	if err := h.SubmitRatings(rr, req, input); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, rr.Code)
+	}
+}
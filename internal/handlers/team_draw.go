@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"fut-app/internal/handlers/dto"
+	"fut-app/internal/handlers/httprespond"
+	"fut-app/internal/usecase"
+)
+
+type TeamDrawHandler struct {
+	drawTeams usecase.DrawTeamsUseCase
+}
+
+func NewTeamDrawHandler(draw usecase.DrawTeamsUseCase) *TeamDrawHandler {
+	return &TeamDrawHandler{
+		drawTeams: draw,
+	}
+}
+
+func (h *TeamDrawHandler) DrawTeams(w http.ResponseWriter, r *http.Request, d dto.DrawTeamsDTO) error {
+	matchID, err := pathID(r)
+	if err != nil {
+		return err
+	}
+
+	draw, err := h.drawTeams.Execute(r.Context(), d.ToDomain(matchID))
+	if err != nil {
+		return err
+	}
+	return httprespond.JSON(w, http.StatusOK, draw)
+}
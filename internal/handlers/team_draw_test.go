@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/handlers/dto"
+
+	"github.com/gorilla/mux"
+)
+
+type stubDrawTeamsUseCase struct {
+	executeFn func(domain.DrawRequest) (*domain.TeamDraw, error)
+}
+
+func (s *stubDrawTeamsUseCase) Execute(_ context.Context, req domain.DrawRequest) (*domain.TeamDraw, error) {
+	return s.executeFn(req)
+}
+
+func TestTeamDrawHandler_DrawTeams_DefaultsToTwoTeams(t *testing.T) {
+	uc := &stubDrawTeamsUseCase{
+		executeFn: func(req domain.DrawRequest) (*domain.TeamDraw, error) {
+			if req.MatchID != 6 || req.Teams != 2 || len(req.KeepApart) != 1 {
+				t.Fatalf("unexpected request: %+v", req)
+			}
+			return &domain.TeamDraw{MatchID: req.MatchID}, nil
+		},
+	}
+
+	h := NewTeamDrawHandler(uc)
+	rr := httptest.NewRecorder()
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/matches/6/draw-teams", nil), map[string]string{"id": "6"})
+
+	err := h.DrawTeams(rr, req, dto.DrawTeamsDTO{PlayerIDs: []uint{1, 2, 3, 4}, KeepApart: [][]uint{{1, 2}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+}
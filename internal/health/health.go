@@ -0,0 +1,146 @@
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+
+	defaultTimeout = 2 * time.Second
+)
+
+type (
+	// CheckFunc verifica uma dependência; details vai para a resposta mesmo quando há erro.
+	CheckFunc func(ctx context.Context) (details map[string]any, err error)
+
+	// Result é o resultado de uma verificação. Uma falha em verificação não crítica aparece
+	// na resposta mas não tira o serviço do ar.
+	Result struct {
+		Status    string         `json:"status"`
+		Critical  bool           `json:"critical"`
+		LatencyMS float64        `json:"latency_ms"`
+		Details   map[string]any `json:"details,omitempty"`
+		Error     string         `json:"error,omitempty"`
+	}
+
+	Report struct {
+		Status string            `json:"status"`
+		Checks map[string]Result `json:"checks"`
+	}
+
+	// Registry guarda as verificações de prontidão; cada dependência nova se registra aqui.
+	Registry struct {
+		mu      sync.RWMutex
+		checks  map[string]check
+		timeout time.Duration
+	}
+
+	check struct {
+		critical bool
+		fn       CheckFunc
+	}
+)
+
+// NewRegistry cria o registro; timeout limita cada verificação (2s quando zero).
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Registry{checks: make(map[string]check), timeout: timeout}
+}
+
+// Register adiciona ou substitui a verificação name.
+func (r *Registry) Register(name string, critical bool, fn CheckFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = check{critical: critical, fn: fn}
+}
+
+// Names devolve as verificações registradas em ordem alfabética.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.checks))
+	for name := range r.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run executa todas as verificações em paralelo. O relatório fica down quando alguma
+// verificação crítica falha.
+func (r *Registry) Run(ctx context.Context) Report {
+	r.mu.RLock()
+	checks := make(map[string]check, len(r.checks))
+	for name, c := range r.checks {
+		checks[name] = c
+	}
+	r.mu.RUnlock()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]Result, len(checks))
+	)
+	for name, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := r.run(ctx, c)
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Checks: results}
+	for _, result := range results {
+		if result.Critical && result.Status == StatusDown {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+// run não espera além do timeout mesmo que a verificação ignore o contexto.
+func (r *Registry) run(ctx context.Context, c check) Result {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	type outcome struct {
+		details map[string]any
+		err     error
+	}
+	done := make(chan outcome, 1)
+	start := time.Now()
+	go func() {
+		details, err := c.fn(ctx)
+		done <- outcome{details, err}
+	}()
+
+	var out outcome
+	select {
+	case out = <-done:
+	case <-ctx.Done():
+		out.err = ctx.Err()
+	}
+
+	result := Result{
+		Status:    StatusUp,
+		Critical:  c.critical,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+		Details:   out.details,
+	}
+	if out.err != nil {
+		result.Status = StatusDown
+		result.Error = out.err.Error()
+	}
+	return result
+}
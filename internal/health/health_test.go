@@ -0,0 +1,90 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func up(ctx context.Context) (map[string]any, error) {
+	return map[string]any{"ok": true}, nil
+}
+
+func down(ctx context.Context) (map[string]any, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestRegistry_Run_AllUp(t *testing.T) {
+	registry := NewRegistry(time.Second)
+	registry.Register("database", true, up)
+	registry.Register("cache", false, up)
+
+	report := registry.Run(context.Background())
+	if report.Status != StatusUp {
+		t.Errorf("Run() status = %s, want up", report.Status)
+	}
+	if len(report.Checks) != 2 || report.Checks["database"].Details["ok"] != true {
+		t.Errorf("Run() checks = %+v", report.Checks)
+	}
+}
+
+func TestRegistry_Run_CriticalFailure(t *testing.T) {
+	registry := NewRegistry(time.Second)
+	registry.Register("database", true, down)
+	registry.Register("cache", false, up)
+
+	report := registry.Run(context.Background())
+	if report.Status != StatusDown {
+		t.Errorf("Run() status = %s, want down", report.Status)
+	}
+	if got := report.Checks["database"]; got.Status != StatusDown || got.Error != "connection refused" {
+		t.Errorf("Run() database = %+v", got)
+	}
+}
+
+func TestRegistry_Run_NonCriticalFailure(t *testing.T) {
+	registry := NewRegistry(time.Second)
+	registry.Register("database", true, up)
+	registry.Register("cache", false, down)
+
+	report := registry.Run(context.Background())
+	if report.Status != StatusUp {
+		t.Errorf("Run() status = %s, want up when only a non-critical check fails", report.Status)
+	}
+	if report.Checks["cache"].Status != StatusDown {
+		t.Errorf("Run() cache = %+v, want down", report.Checks["cache"])
+	}
+}
+
+func TestRegistry_Run_TimesOutStuckCheck(t *testing.T) {
+	registry := NewRegistry(20 * time.Millisecond)
+	release := make(chan struct{})
+	defer close(release)
+	registry.Register("stuck", true, func(ctx context.Context) (map[string]any, error) {
+		<-release // ignora o contexto de propósito
+		return nil, nil
+	})
+
+	start := time.Now()
+	report := registry.Run(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Run() took %v, want it bounded by the check timeout", elapsed)
+	}
+	if got := report.Checks["stuck"]; got.Status != StatusDown || got.Error != context.DeadlineExceeded.Error() {
+		t.Errorf("Run() stuck = %+v, want down with deadline exceeded", got)
+	}
+}
+
+func TestRegistry_Register_Replaces(t *testing.T) {
+	registry := NewRegistry(0)
+	registry.Register("database", true, down)
+	registry.Register("database", true, up)
+
+	if names := registry.Names(); len(names) != 1 || names[0] != "database" {
+		t.Errorf("Names() = %v, want [database]", names)
+	}
+	if report := registry.Run(context.Background()); report.Status != StatusUp {
+		t.Errorf("Run() status = %s, want the replacement check to win", report.Status)
+	}
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"fut-app/internal/database"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "futapp"
+
+	// unmatchedRoute agrupa requisições sem rota, para que caminhos arbitrários não virem
+	// séries novas.
+	unmatchedRoute = "unmatched"
+)
+
+// Metrics concentra os coletores expostos em /metrics num registro próprio.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests  *prometheus.CounterVec
+	httpDuration  *prometheus.HistogramVec
+	queryDuration *prometheus.HistogramVec
+
+	playersRegistered prometheus.Counter
+	ratingsSubmitted  prometheus.Counter
+	matchesFinished   prometheus.Counter
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route template and status.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method, route template and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "GORM statement duration by operation and table.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "table"}),
+		playersRegistered: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "players_registered_total",
+			Help:      "Players registered.",
+		}),
+		ratingsSubmitted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "ratings_submitted_total",
+			Help:      "Individual ratings submitted.",
+		}),
+		matchesFinished: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "matches_finished_total",
+			Help:      "Matches moved to the finished status.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.queryDuration,
+		m.playersRegistered,
+		m.ratingsSubmitted,
+		m.matchesFinished,
+	)
+	return m
+}
+
+// Handler serve o formato de exposição do Prometheus.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveRequest registra uma requisição concluída; route é o template do mux.
+func (m *Metrics) ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	if route == "" {
+		route = unmatchedRoute
+	}
+	labels := []string{method, route, strconv.Itoa(status)}
+	m.httpRequests.WithLabelValues(labels...).Inc()
+	m.httpDuration.WithLabelValues(labels...).Observe(elapsed.Seconds())
+}
+
+// RegisterDatabase expõe as estatísticas do pool e as repetições de Database e instala o
+// plugin que mede a duração de cada instrução do GORM.
+func (m *Metrics) RegisterDatabase(db *database.Database, name string) error {
+	sqlDB, err := db.DB.DB()
+	if err != nil {
+		return fmt.Errorf("❌ Failed to get sql.DB: %w", err)
+	}
+
+	retries := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_retries_total",
+		Help:      "Database operations retried after a transient error.",
+	}, func() float64 { return float64(db.RetryStats().Retries) })
+	exhausted := prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_retries_exhausted_total",
+		Help:      "Database operations that failed after every retry attempt.",
+	}, func() float64 { return float64(db.RetryStats().Exhausted) })
+
+	for _, c := range []prometheus.Collector{collectors.NewDBStatsCollector(sqlDB, name), retries, exhausted} {
+		if err := m.registry.Register(c); err != nil {
+			return err
+		}
+	}
+	return db.Use(&gormPlugin{duration: m.queryDuration})
+}
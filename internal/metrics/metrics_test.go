@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"fut-app/internal/database"
+	"fut-app/internal/domain"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/logger"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	rr := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	return rr.Body.String()
+}
+
+func TestMetrics_ObserveRequest(t *testing.T) {
+	m := New()
+	m.ObserveRequest(http.MethodGet, "/players/{id:[0-9]+}", http.StatusOK, 20*time.Millisecond)
+	m.ObserveRequest(http.MethodGet, "", http.StatusNotFound, time.Millisecond)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET", "/players/{id:[0-9]+}", "200")))
+	// Caminhos sem rota caem todos na mesma série.
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues("GET", unmatchedRoute, "404")))
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `futapp_http_request_duration_seconds_count{method="GET",route="/players/{id:[0-9]+}",status="200"} 1`)
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestMetrics_RegisterDatabase(t *testing.T) {
+	db, err := database.NewDatabase(&database.Config{Driver: database.DriverSQLite, SQLitePath: ":memory:", LogLevel: logger.Silent})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	m := New()
+	require.NoError(t, m.RegisterDatabase(db, "sqlite"))
+
+	var count int64
+	require.NoError(t, db.WithContext(context.Background()).Table("sqlite_master").Count(&count).Error)
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `futapp_db_query_duration_seconds_count{operation="query",table="sqlite_master"} 1`)
+	assert.Contains(t, body, `go_sql_open_connections{db_name="sqlite"}`)
+	assert.Contains(t, body, "futapp_db_retries_total 0")
+	assert.Contains(t, body, "futapp_db_retries_exhausted_total 0")
+}
+
+type (
+	stubRegisterPlayer struct{ err error }
+	stubSubmitRatings  struct{ saved []domain.Rating }
+)
+
+func (s stubRegisterPlayer) Execute(_ context.Context, p domain.Player) (*domain.Player, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &p, nil
+}
+
+func (s stubSubmitRatings) Execute(context.Context, domain.RatingSubmission) ([]domain.Rating, error) {
+	return s.saved, nil
+}
+
+func TestMetrics_RegisterPlayerCountsSuccessOnly(t *testing.T) {
+	m := New()
+	ctx := context.Background()
+
+	_, _ = m.RegisterPlayer(stubRegisterPlayer{}).Execute(ctx, domain.Player{Name: "Zico"})
+	_, _ = m.RegisterPlayer(stubRegisterPlayer{err: errors.New("boom")}).Execute(ctx, domain.Player{Name: "Zico"})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.playersRegistered))
+}
+
+func TestMetrics_SubmitRatingsCountsEachRating(t *testing.T) {
+	m := New()
+
+	_, err := m.SubmitRatings(stubSubmitRatings{saved: make([]domain.Rating, 3)}).Execute(context.Background(), domain.RatingSubmission{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.ratingsSubmitted))
+}
+
+func TestMetrics_MatchFinishedCountsEachMatch(t *testing.T) {
+	m := New()
+
+	m.MatchFinished(context.Background(), domain.Match{ID: 1})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.matchesFinished))
+}
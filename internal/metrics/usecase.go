@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/usecase"
+)
+
+// Os decorators abaixo contam os fatos de domínio só depois que o use case teve sucesso,
+// sem que os use cases precisem conhecer o Prometheus.
+
+type (
+	registerPlayer struct {
+		usecase.RegisterPlayerUseCase
+		m *Metrics
+	}
+	submitRatings struct {
+		usecase.SubmitRatingsUseCase
+		m *Metrics
+	}
+)
+
+func (m *Metrics) RegisterPlayer(uc usecase.RegisterPlayerUseCase) usecase.RegisterPlayerUseCase {
+	return &registerPlayer{RegisterPlayerUseCase: uc, m: m}
+}
+
+func (uc *registerPlayer) Execute(ctx context.Context, player domain.Player) (*domain.Player, error) {
+	created, err := uc.RegisterPlayerUseCase.Execute(ctx, player)
+	if err == nil {
+		uc.m.playersRegistered.Inc()
+	}
+	return created, err
+}
+
+func (m *Metrics) SubmitRatings(uc usecase.SubmitRatingsUseCase) usecase.SubmitRatingsUseCase {
+	return &submitRatings{SubmitRatingsUseCase: uc, m: m}
+}
+
+func (uc *submitRatings) Execute(ctx context.Context, submission domain.RatingSubmission) ([]domain.Rating, error) {
+	saved, err := uc.SubmitRatingsUseCase.Execute(ctx, submission)
+	if err == nil {
+		uc.m.ratingsSubmitted.Add(float64(len(saved)))
+	}
+	return saved, err
+}
+
+// MatchFinished implementa usecase.MatchObserver: o use case de atualização conhece o status
+// anterior gravado e só avisa quando a partida de fato passa a finished.
+func (m *Metrics) MatchFinished(context.Context, domain.Match) {
+	m.matchesFinished.Inc()
+}
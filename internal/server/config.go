@@ -0,0 +1,35 @@
+package server
+
+import (
+	"time"
+
+	"fut-app/pkg/env"
+)
+
+type Config struct {
+	Port              string
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	// WriteTimeout precisa cobrir o timeout da rota mais lenta, senão a resposta é cortada.
+	WriteTimeout   time.Duration
+	IdleTimeout    time.Duration
+	MaxHeaderBytes int
+	// DrainDelay é quanto o servidor continua atendendo com /health fora do ar antes de
+	// parar de aceitar conexões, para o orquestrador tirá-lo do balanceamento.
+	DrainDelay time.Duration
+	// ShutdownTimeout limita a espera pelas requisições em andamento.
+	ShutdownTimeout time.Duration
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Port:              env.String("HTTP_PORT", "8080"),
+		ReadTimeout:       env.Duration("HTTP_READ_TIMEOUT", 15*time.Second),
+		ReadHeaderTimeout: env.Duration("HTTP_READ_HEADER_TIMEOUT", 5*time.Second),
+		WriteTimeout:      env.Duration("HTTP_WRITE_TIMEOUT", 3*time.Minute),
+		IdleTimeout:       env.Duration("HTTP_IDLE_TIMEOUT", time.Minute),
+		MaxHeaderBytes:    env.Int("HTTP_MAX_HEADER_BYTES", 1<<20),
+		DrainDelay:        env.Duration("HTTP_DRAIN_DELAY", 5*time.Second),
+		ShutdownTimeout:   env.Duration("HTTP_SHUTDOWN_TIMEOUT", 30*time.Second),
+	}
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+type Server struct {
+	http     *http.Server
+	config   *Config
+	logger   *slog.Logger
+	draining atomic.Bool
+}
+
+func New(config *Config, handler http.Handler, logger *slog.Logger) *Server {
+	return &Server{
+		http: &http.Server{
+			Addr:              ":" + config.Port,
+			Handler:           handler,
+			ReadTimeout:       config.ReadTimeout,
+			ReadHeaderTimeout: config.ReadHeaderTimeout,
+			WriteTimeout:      config.WriteTimeout,
+			IdleTimeout:       config.IdleTimeout,
+			MaxHeaderBytes:    config.MaxHeaderBytes,
+			ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelError),
+		},
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run escuta na porta configurada até ctx ser cancelado e então faz o Shutdown.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.http.Addr)
+	if err != nil {
+		return fmt.Errorf("❌ Failed to listen on %s: %w", s.http.Addr, err)
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve atende em listener até ctx ser cancelado. Aí HealthCheck passa a falhar,
+// o servidor espera DrainDelay e só então para de aceitar conexões, aguardando as
+// requisições em andamento por até ShutdownTimeout.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("🚀 Server is running", slog.String("addr", listener.Addr().String()))
+		errCh <- s.http.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.draining.Store(true)
+	s.logger.Info("🛑 Shutting down, draining requests", slog.Duration("delay", s.config.DrainDelay))
+	time.Sleep(s.config.DrainDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+	if err := s.http.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("❌ Failed to drain in-flight requests: %w", err)
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	s.logger.Info("✅ Server stopped")
+	return nil
+}
+
+// Draining indica que o servidor recebeu o sinal de parada e não deve receber tráfego novo.
+func (s *Server) Draining() bool {
+	return s.draining.Load()
+}
+
+// ErrDraining é a falha da verificação de prontidão enquanto o servidor encerra.
+var ErrDraining = errors.New("server is draining")
+
+// HealthCheck falha durante o drain para que /health/ready tire o servidor do balanceamento.
+func (s *Server) HealthCheck(ctx context.Context) (map[string]any, error) {
+	if s.Draining() {
+		return map[string]any{"draining": true}, ErrDraining
+	}
+	return map[string]any{"draining": false}, nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewConfig_FromEnv(t *testing.T) {
+	t.Setenv("HTTP_PORT", "9090")
+	t.Setenv("HTTP_WRITE_TIMEOUT", "45s")
+	t.Setenv("HTTP_MAX_HEADER_BYTES", "4096")
+	t.Setenv("HTTP_IDLE_TIMEOUT", "not-a-duration")
+
+	config := NewConfig()
+	if config.Port != "9090" || config.WriteTimeout != 45*time.Second || config.MaxHeaderBytes != 4096 {
+		t.Errorf("NewConfig() = %+v", config)
+	}
+	if config.IdleTimeout != time.Minute {
+		t.Errorf("NewConfig() IdleTimeout = %v, want default for invalid value", config.IdleTimeout)
+	}
+}
+
+func TestServer_HealthCheck(t *testing.T) {
+	srv := New(NewConfig(), http.NewServeMux(), slog.Default())
+
+	if _, err := srv.HealthCheck(context.Background()); err != nil {
+		t.Errorf("HealthCheck() error = %v, want nil", err)
+	}
+
+	srv.draining.Store(true)
+	if _, err := srv.HealthCheck(context.Background()); !errors.Is(err, ErrDraining) {
+		t.Errorf("HealthCheck() while draining error = %v, want ErrDraining", err)
+	}
+}
+
+func TestServer_Serve_DrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		_, _ = io.WriteString(w, "done")
+	})
+
+	config := NewConfig()
+	config.DrainDelay = 50 * time.Millisecond
+	config.ShutdownTimeout = 5 * time.Second
+	srv := New(config, mux, slog.Default())
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := srv.HealthCheck(r.Context()); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	base := "http://" + listener.Addr().String()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(ctx, listener) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	slow := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(base + "/slow")
+		if err != nil {
+			slow <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		slow <- result{body: string(body), err: err}
+	}()
+	<-started
+
+	cancel()
+	// Durante o DrainDelay o servidor ainda atende, mas /health já está fora.
+	deadline := time.Now().Add(time.Second)
+	for !srv.Draining() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	resp, err := http.Get(base + "/health")
+	if err != nil {
+		t.Fatalf("GET /health during drain error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("GET /health during drain status = %d, want 503", resp.StatusCode)
+	}
+
+	close(release)
+	if got := <-slow; got.err != nil || got.body != "done" {
+		t.Errorf("in-flight request = %+v, want it to finish", got)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("Serve() error = %v, want nil", err)
+	}
+}
@@ -1,59 +0,0 @@
-package services
-
-//
-//import (
-//	"errors"
-//
-//	"fut-app/internal/database/repositories"
-//
-//	"fut-app/internal/domain"
-//
-//	"fut-app/internal/database/models"
-//)
-//
-//type (
-//	PlayerService interface {
-//		// CreatePlayer(domain.Player) error
-//		GetAllPlayers() []models.Player
-//		GetPlayerByID(uint) (*models.Player, error)
-//		UpdatePlayer(domain.Player, uint) error
-//		DeletePlayer(uint) error
-//	}
-//	playerimpl struct {
-//		repo repositories.PlayerRepository
-//	}
-//)
-//
-//func NewPlayerService(repo repositories.PlayerRepository) PlayerService {
-//	return &playerimpl{repo: repo}
-//}
-//
-////func (s *playerimpl) CreatePlayer(p domain.Player) error {
-////	if err := p.Validate(); err != nil {
-////		return err
-////	}
-////	return s.repo.CreatePlayer(player)
-////}
-//
-//func (s *playerimpl) GetAllPlayers() []models.Player {
-//	return s.repo.GetPlayers()
-//}
-//
-//func (s *playerimpl) GetPlayerByID(id uint) (*models.Player, error) {
-//	return s.repo.GetPlayerByID(id)
-//}
-//
-//func (s *playerimpl) UpdatePlayer(player domain.Player, id uint) error {
-//	p, err := s.repo.GetPlayerByID(id)
-//	if err != nil {
-//		return errors.New("error") // TODO refatorar
-//	}
-//	if p == nil {
-//		return errors.New("error") // TODO refatorar
-//	}
-//	return s.repo.UpdatePlayer(player)
-//}
-//
-//func (s *playerimpl) DeletePlayer(id uint) error {
-//	return s.repo.DeletePlayer(id)
-//}
@@ -0,0 +1,16 @@
+package usecase
+
+import "fut-app/internal/domain"
+
+// Serviços de autenticação usados pelos use cases de conta; internal/auth implementa.
+type (
+	TokenService interface {
+		Issue(domain.Principal) (*domain.TokenPair, error)
+		Parse(token string, kind domain.TokenKind) (*domain.TokenClaims, error)
+	}
+	PasswordHasher interface {
+		Hash(password string) (string, error)
+		// Compare devolve ErrUnauthorized quando a senha não confere.
+		Compare(hash, password string) error
+	}
+)
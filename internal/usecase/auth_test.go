@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+// Fakes compartilhados pelos testes de conta: tokens são "kind:account:jti" e o hash de
+// uma senha é "hash:" + senha.
+type fakeTokens struct {
+	issued []domain.Principal
+}
+
+func (f *fakeTokens) Issue(p domain.Principal) (*domain.TokenPair, error) {
+	f.issued = append(f.issued, p)
+	return &domain.TokenPair{AccessToken: "access", RefreshToken: "refresh", TokenType: domain.TokenTypeBearer}, nil
+}
+
+func (f *fakeTokens) Parse(token string, kind domain.TokenKind) (*domain.TokenClaims, error) {
+	claims, ok := fakeTokenClaims[token]
+	if !ok || claims.Kind != kind {
+		return nil, apperrors.ErrUnauthorized
+	}
+	return &claims, nil
+}
+
+var fakeTokenClaims = map[string]domain.TokenClaims{
+	"access-1":  {Principal: domain.Principal{AccountID: 1}, ID: "a1", Kind: domain.AccessToken},
+	"refresh-1": {Principal: domain.Principal{AccountID: 1}, ID: "r1", Kind: domain.RefreshToken},
+	"refresh-2": {Principal: domain.Principal{AccountID: 2}, ID: "r2", Kind: domain.RefreshToken},
+}
+
+type fakeHasher struct{}
+
+func (fakeHasher) Hash(password string) (string, error) {
+	return "hash:" + password, nil
+}
+
+func (fakeHasher) Compare(hash, password string) error {
+	if hash != "hash:"+password {
+		return apperrors.ErrUnauthorized
+	}
+	return nil
+}
+
+type fakeAccountGateway struct {
+	accounts map[uint]domain.Account
+	revoked  map[string]bool
+	created  *domain.Account
+}
+
+func newFakeAccountGateway() *fakeAccountGateway {
+	return &fakeAccountGateway{
+		accounts: map[uint]domain.Account{
+			1: {ID: 1, Email: "zico@fut.app", PasswordHash: "hash:galinho1", Role: domain.RoleOrganiser},
+		},
+		revoked: map[string]bool{},
+	}
+}
+
+func (f *fakeAccountGateway) Create(_ context.Context, account domain.Account) (*domain.Account, error) {
+	account.ID = 2
+	f.created = &account
+	return &account, nil
+}
+
+func (f *fakeAccountGateway) Get(_ context.Context, id uint) (*domain.Account, error) {
+	account, ok := f.accounts[id]
+	if !ok {
+		return nil, apperrors.ErrNotFound
+	}
+	return &account, nil
+}
+
+func (f *fakeAccountGateway) GetByEmail(_ context.Context, email string) (*domain.Account, error) {
+	for _, account := range f.accounts {
+		if account.Email == email {
+			return &account, nil
+		}
+	}
+	return nil, apperrors.ErrNotFound
+}
+
+func (f *fakeAccountGateway) Revoke(_ context.Context, tokenID string, _ time.Time) error {
+	if f.revoked[tokenID] {
+		return apperrors.ErrAlreadyExists
+	}
+	f.revoked[tokenID] = true
+	return nil
+}
+
+func (f *fakeAccountGateway) IsRevoked(_ context.Context, tokenID string) (bool, error) {
+	return f.revoked[tokenID], nil
+}
+
+// asRole e asPlayer montam o contexto de uma requisição autenticada dentro do grupo 1, para
+// os use cases que passam pela política de acesso. O papel de asRole é o da conta.
+func asRole(role domain.Role) context.Context {
+	return asMember(99, role, domain.RolePlayer)
+}
+
+func asPlayer(playerID uint) context.Context {
+	return asMember(playerID, domain.RolePlayer, domain.RolePlayer)
+}
+
+// asMember é a conta playerID, com o papel accountRole, membro do grupo 1 com o papel
+// groupRole e o jogador de mesmo ID.
+func asMember(playerID uint, accountRole, groupRole domain.Role) context.Context {
+	ctx := domain.WithPrincipal(context.Background(), domain.TokenClaims{
+		Principal: domain.Principal{AccountID: playerID, Role: accountRole},
+	})
+	return domain.WithMembership(ctx, domain.Membership{GroupID: 1, AccountID: playerID, PlayerID: playerID, Role: groupRole})
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	AuthenticateUseCase interface {
+		Execute(ctx context.Context, accessToken string) (*domain.TokenClaims, error)
+	}
+	AuthenticateGateway interface {
+		Get(ctx context.Context, id uint) (*domain.Account, error)
+		IsRevoked(ctx context.Context, tokenID string) (bool, error)
+	}
+	authenticate struct {
+		gateway AuthenticateGateway
+		tokens  TokenService
+	}
+)
+
+func NewAuthenticateUseCase(gateway AuthenticateGateway, tokens TokenService) AuthenticateUseCase {
+	return &authenticate{gateway: gateway, tokens: tokens}
+}
+
+// Execute valida o token de acesso e confere que ele não foi revogado e que a conta e o
+// jogador ainda existem; qualquer falha é ErrUnauthorized.
+func (uc *authenticate) Execute(ctx context.Context, token string) (_ *domain.TokenClaims, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.Authenticate")
+	defer tracing.End(span, &err)
+
+	claims, err := uc.tokens.Parse(token, domain.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := uc.gateway.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, appErr.ErrUnauthorized
+	}
+
+	account, err := uc.gateway.Get(ctx, claims.AccountID)
+	if errors.Is(err, appErr.ErrNotFound) {
+		return nil, appErr.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+	claims.Role = account.Role
+	return claims, nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+func TestAuthenticateUseCase_Execute_Success(t *testing.T) {
+	uc := NewAuthenticateUseCase(newFakeAccountGateway(), &fakeTokens{})
+
+	claims, err := uc.Execute(context.Background(), "access-1")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if claims.AccountID != 1 || claims.Role != domain.RoleOrganiser {
+		t.Errorf("Execute() claims = %+v", claims)
+	}
+}
+
+func TestAuthenticateUseCase_Execute_Unauthorized(t *testing.T) {
+	revoked := newFakeAccountGateway()
+	revoked.revoked["a1"] = true
+	deleted := newFakeAccountGateway()
+	delete(deleted.accounts, 1)
+
+	tests := []struct {
+		name  string
+		gw    *fakeAccountGateway
+		token string
+	}{
+		{"refresh token", newFakeAccountGateway(), "refresh-1"},
+		{"revoked", revoked, "access-1"},
+		{"deleted account", deleted, "access-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewAuthenticateUseCase(tt.gw, &fakeTokens{})
+
+			if _, err := uc.Execute(context.Background(), tt.token); !errors.Is(err, apperrors.ErrUnauthorized) {
+				t.Errorf("Execute() error = %v, want ErrUnauthorized", err)
+			}
+		})
+	}
+}
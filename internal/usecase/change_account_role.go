@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ChangeAccountRoleUseCase interface {
+		Execute(ctx context.Context, accountID uint, role domain.Role) (*domain.Account, error)
+	}
+	ChangeAccountRoleGateway interface {
+		SetRole(ctx context.Context, id uint, role domain.Role) (*domain.Account, error)
+	}
+	changeAccountRole struct {
+		gateway ChangeAccountRoleGateway
+	}
+)
+
+func NewChangeAccountRoleUseCase(gateway ChangeAccountRoleGateway) ChangeAccountRoleUseCase {
+	return &changeAccountRole{gateway: gateway}
+}
+
+// Execute só é permitido a admins, e um admin não muda o próprio papel: assim sempre sobra
+// ao menos um admin para desfazer a troca.
+func (uc *changeAccountRole) Execute(ctx context.Context, accountID uint, role domain.Role) (_ *domain.Account, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ChangeAccountRole")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageAccounts); err != nil {
+		return nil, err
+	}
+	if claims, _ := domain.PrincipalFromContext(ctx); claims.AccountID == accountID {
+		return nil, fmt.Errorf("%w: cannot change own role", appErr.ErrForbidden)
+	}
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.gateway.SetRole(ctx, accountID, role)
+}
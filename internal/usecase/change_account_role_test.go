@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockChangeAccountRoleGateway struct {
+	called bool
+}
+
+func (m *mockChangeAccountRoleGateway) SetRole(_ context.Context, id uint, role domain.Role) (*domain.Account, error) {
+	m.called = true
+	return &domain.Account{ID: id, Role: role}, nil
+}
+
+func TestChangeAccountRoleUseCase_Execute_Success(t *testing.T) {
+	gw := &mockChangeAccountRoleGateway{}
+	useCase := NewChangeAccountRoleUseCase(gw)
+
+	account, err := useCase.Execute(asRole(domain.RoleAdmin), 5, domain.RoleOrganiser)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if account.Role != domain.RoleOrganiser {
+		t.Errorf("Execute() role = %v, want organiser", account.Role)
+	}
+}
+
+func TestChangeAccountRoleUseCase_Execute_Denied(t *testing.T) {
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		accountID uint
+		role      domain.Role
+		want      error
+	}{
+		{"anonymous", context.Background(), 5, domain.RoleOrganiser, apperrors.ErrUnauthorized},
+		{"organiser", asRole(domain.RoleOrganiser), 5, domain.RoleOrganiser, apperrors.ErrForbidden},
+		{"own role", asRole(domain.RoleAdmin), 99, domain.RolePlayer, apperrors.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := &mockChangeAccountRoleGateway{}
+			useCase := NewChangeAccountRoleUseCase(gw)
+
+			if _, err := useCase.Execute(tt.ctx, tt.accountID, tt.role); !errors.Is(err, tt.want) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.want)
+			}
+			if gw.called {
+				t.Error("Execute() should not call gateway")
+			}
+		})
+	}
+}
+
+func TestChangeAccountRoleUseCase_Execute_UnknownRole(t *testing.T) {
+	useCase := NewChangeAccountRoleUseCase(&mockChangeAccountRoleGateway{})
+
+	_, err := useCase.Execute(asRole(domain.RoleAdmin), 5, "captain")
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want *ValidationErrors", err)
+	}
+}
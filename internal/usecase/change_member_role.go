@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ChangeMemberRoleUseCase interface {
+		Execute(ctx context.Context, playerID uint, role domain.Role) (*domain.GroupMember, error)
+	}
+	ChangeMemberRoleGateway interface {
+		SetMemberRole(ctx context.Context, playerID uint, role domain.Role) (*domain.GroupMember, error)
+	}
+	changeMemberRole struct {
+		gateway ChangeMemberRoleGateway
+	}
+)
+
+func NewChangeMemberRoleUseCase(gateway ChangeMemberRoleGateway) ChangeMemberRoleUseCase {
+	return &changeMemberRole{gateway: gateway}
+}
+
+// Execute troca o papel do membro dono do jogador no grupo da requisição. Como na troca de
+// papel da conta, ninguém muda o próprio papel, e o grupo não fica sem organizador por engano.
+func (uc *changeMemberRole) Execute(ctx context.Context, playerID uint, role domain.Role) (_ *domain.GroupMember, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ChangeMemberRole")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageGroup); err != nil {
+		return nil, err
+	}
+	if m, _ := domain.MembershipFromContext(ctx); m.PlayerID == playerID {
+		return nil, fmt.Errorf("%w: cannot change own role", appErr.ErrForbidden)
+	}
+	if err := domain.ValidateGroupRole(role); err != nil {
+		return nil, err
+	}
+	return uc.gateway.SetMemberRole(ctx, playerID, role)
+}
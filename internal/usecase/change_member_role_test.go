@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockChangeMemberRoleGateway struct {
+	called bool
+}
+
+func (m *mockChangeMemberRoleGateway) SetMemberRole(_ context.Context, playerID uint, role domain.Role) (*domain.GroupMember, error) {
+	m.called = true
+	return &domain.GroupMember{Membership: domain.Membership{PlayerID: playerID, Role: role}}, nil
+}
+
+func TestChangeMemberRoleUseCase_Execute_Success(t *testing.T) {
+	useCase := NewChangeMemberRoleUseCase(&mockChangeMemberRoleGateway{})
+
+	member, err := useCase.Execute(asMember(7, domain.RolePlayer, domain.RoleOrganiser), 5, domain.RoleOrganiser)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if member.PlayerID != 5 || member.Role != domain.RoleOrganiser {
+		t.Errorf("Execute() = %+v", member)
+	}
+}
+
+func TestChangeMemberRoleUseCase_Execute_Denied(t *testing.T) {
+	organiser := asMember(7, domain.RolePlayer, domain.RoleOrganiser)
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		playerID uint
+		role     domain.Role
+		want     error
+	}{
+		{"anonymous", context.Background(), 5, domain.RoleOrganiser, apperrors.ErrUnauthorized},
+		{"player", asPlayer(7), 5, domain.RoleOrganiser, apperrors.ErrForbidden},
+		{"own role", organiser, 7, domain.RolePlayer, apperrors.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := &mockChangeMemberRoleGateway{}
+			useCase := NewChangeMemberRoleUseCase(gw)
+
+			if _, err := useCase.Execute(tt.ctx, tt.playerID, tt.role); !errors.Is(err, tt.want) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.want)
+			}
+			if gw.called {
+				t.Error("Execute() should not call gateway")
+			}
+		})
+	}
+}
+
+func TestChangeMemberRoleUseCase_Execute_AdminIsNotAGroupRole(t *testing.T) {
+	useCase := NewChangeMemberRoleUseCase(&mockChangeMemberRoleGateway{})
+
+	_, err := useCase.Execute(asMember(7, domain.RolePlayer, domain.RoleOrganiser), 5, domain.RoleAdmin)
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want *ValidationErrors", err)
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+// joinCodeAttempts limita os sorteios quando o código de convite colide com o de outro grupo.
+const joinCodeAttempts = 3
+
+type (
+	CreateGroupUseCase interface {
+		Execute(context.Context, domain.NewGroup) (*domain.Group, error)
+	}
+	CreateGroupGateway interface {
+		Create(ctx context.Context, group domain.Group, accountID uint, player domain.Player) (*domain.Group, error)
+	}
+	createGroup struct {
+		gateway CreateGroupGateway
+	}
+)
+
+func NewCreateGroupUseCase(gateway CreateGroupGateway) CreateGroupUseCase {
+	return &createGroup{gateway: gateway}
+}
+
+// Execute cria o grupo com o jogador de quem pede, que vira organizador dele.
+func (uc *createGroup) Execute(ctx context.Context, group domain.NewGroup) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.CreateGroup")
+	defer tracing.End(span, &err)
+
+	claims, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErr.ErrUnauthorized
+	}
+	if err := group.Validate(); err != nil {
+		return nil, err
+	}
+
+	return withJoinCode(func(code string) (*domain.Group, error) {
+		return uc.gateway.Create(ctx, domain.Group{Name: group.Name, JoinCode: code}, claims.AccountID, group.Player.ToPlayer())
+	})
+}
+
+// withJoinCode sorteia um código novo a cada colisão, até joinCodeAttempts vezes.
+func withJoinCode(save func(code string) (*domain.Group, error)) (*domain.Group, error) {
+	var err error
+	for range joinCodeAttempts {
+		var code string
+		if code, err = domain.NewJoinCode(); err != nil {
+			return nil, err
+		}
+		var group *domain.Group
+		if group, err = save(code); !errors.Is(err, appErr.ErrAlreadyExists) {
+			return group, err
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockCreateGroupGateway struct {
+	collisions int
+	codes      []string
+	accountID  uint
+	player     domain.Player
+}
+
+func (m *mockCreateGroupGateway) Create(_ context.Context, group domain.Group, accountID uint, player domain.Player) (*domain.Group, error) {
+	m.codes = append(m.codes, group.JoinCode)
+	if len(m.codes) <= m.collisions {
+		return nil, apperrors.ErrAlreadyExists
+	}
+	m.accountID = accountID
+	m.player = player
+	group.ID = 1
+	return &group, nil
+}
+
+func newUseCaseGroup() domain.NewGroup {
+	return domain.NewGroup{
+		Name:   "Pelada de quarta",
+		Player: domain.PlayerProfile{Name: "Sócrates", Position: []string{"MC"}},
+	}
+}
+
+func TestCreateGroupUseCase_Execute_Success(t *testing.T) {
+	gw := &mockCreateGroupGateway{}
+	useCase := NewCreateGroupUseCase(gw)
+
+	group, err := useCase.Execute(asPlayer(7), newUseCaseGroup())
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(group.JoinCode) != domain.JoinCodeLength || gw.accountID != 7 {
+		t.Errorf("Execute() group = %+v, account = %d", group, gw.accountID)
+	}
+	if gw.player.Name != "Sócrates" || gw.player.Stats != domain.NeutralStats() {
+		t.Errorf("Execute() player = %+v, want neutral stats", gw.player)
+	}
+}
+
+func TestCreateGroupUseCase_Execute_RetriesJoinCodeCollision(t *testing.T) {
+	gw := &mockCreateGroupGateway{collisions: 1}
+	useCase := NewCreateGroupUseCase(gw)
+
+	if _, err := useCase.Execute(asPlayer(7), newUseCaseGroup()); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(gw.codes) != 2 || gw.codes[0] == gw.codes[1] {
+		t.Errorf("Execute() codes = %v, want a fresh code on retry", gw.codes)
+	}
+}
+
+func TestCreateGroupUseCase_Execute_GivesUpAfterAttempts(t *testing.T) {
+	gw := &mockCreateGroupGateway{collisions: joinCodeAttempts}
+	useCase := NewCreateGroupUseCase(gw)
+
+	if _, err := useCase.Execute(asPlayer(7), newUseCaseGroup()); !errors.Is(err, apperrors.ErrAlreadyExists) {
+		t.Fatalf("Execute() error = %v, want ErrAlreadyExists", err)
+	}
+}
+
+func TestCreateGroupUseCase_Execute_Rejected(t *testing.T) {
+	invalid := newUseCaseGroup()
+	invalid.Name = ""
+
+	gw := &mockCreateGroupGateway{}
+	useCase := NewCreateGroupUseCase(gw)
+
+	if _, err := useCase.Execute(context.Background(), newUseCaseGroup()); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("Execute() anonymous error = %v, want ErrUnauthorized", err)
+	}
+	var ve *apperrors.ValidationErrors
+	if _, err := useCase.Execute(asPlayer(7), invalid); !errors.As(err, &ve) {
+		t.Errorf("Execute() invalid error = %v, want *ValidationErrors", err)
+	}
+	if len(gw.codes) != 0 {
+		t.Error("Execute() should not call gateway")
+	}
+}
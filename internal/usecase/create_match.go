@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	CreateMatchUseCase interface {
+		Execute(context.Context, domain.Match) (*domain.Match, error)
+	}
+	CreateMatchGateway interface {
+		Create(context.Context, domain.Match) (*domain.Match, error)
+	}
+	createMatch struct {
+		gateway CreateMatchGateway
+	}
+)
+
+func NewCreateMatchUseCase(gateway CreateMatchGateway) CreateMatchUseCase {
+	return &createMatch{gateway: gateway}
+}
+
+// Execute cria a partida sempre como agendada; o status só muda via UpdateMatchUseCase.
+func (uc *createMatch) Execute(ctx context.Context, match domain.Match) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.CreateMatch")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageMatches); err != nil {
+		return nil, err
+	}
+
+	match.ID = 0
+	match.Status = domain.MatchScheduled
+	if err := match.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.gateway.Create(ctx, match)
+}
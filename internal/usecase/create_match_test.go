@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockCreateMatchGateway struct {
+	called bool
+	got    domain.Match
+}
+
+func (m *mockCreateMatchGateway) Create(_ context.Context, match domain.Match) (*domain.Match, error) {
+	m.called = true
+	m.got = match
+	match.ID = 1
+	return &match, nil
+}
+
+func newUseCaseMatch() domain.Match {
+	return *domain.NewMatch(
+		"Arena Pelada",
+		time.Date(2026, 10, 22, 21, 0, 0, 0, time.UTC),
+		domain.Team{Name: "Azul", PlayerIDs: []uint{1, 2}},
+		domain.Team{Name: "Branco", PlayerIDs: []uint{3, 4}},
+	)
+}
+
+func TestCreateMatchUseCase_Execute_ForcesScheduled(t *testing.T) {
+	gw := &mockCreateMatchGateway{}
+	useCase := NewCreateMatchUseCase(gw)
+
+	match := newUseCaseMatch()
+	match.Status = domain.MatchFinished
+
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), match)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.got.Status != domain.MatchScheduled {
+		t.Errorf("Execute() status = %v, want scheduled", gw.got.Status)
+	}
+	if result.ID != 1 {
+		t.Errorf("Execute() ID = %d, want 1", result.ID)
+	}
+}
+
+func TestCreateMatchUseCase_Execute_ValidationError(t *testing.T) {
+	gw := &mockCreateMatchGateway{}
+	useCase := NewCreateMatchUseCase(gw)
+
+	match := newUseCaseMatch()
+	match.Venue = ""
+
+	_, err := useCase.Execute(asRole(domain.RoleOrganiser), match)
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want *ValidationErrors", err)
+	}
+	if gw.called {
+		t.Error("Execute() should not call gateway when validation fails")
+	}
+}
+
+func TestCreateMatchUseCase_Execute_Forbidden(t *testing.T) {
+	gw := &mockCreateMatchGateway{}
+	useCase := NewCreateMatchUseCase(gw)
+
+	_, err := useCase.Execute(asPlayer(1), newUseCaseMatch())
+	if !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() error = %v, want ErrForbidden", err)
+	}
+	if gw.called {
+		t.Error("Execute() should not call gateway when the player is not an organiser")
+	}
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	CreatePositionUseCase interface {
+		Execute(context.Context, domain.Position) (*domain.Position, error)
+	}
+	CreatePositionGateway interface {
+		Create(context.Context, domain.Position) (*domain.Position, error)
+	}
+	createPosition struct {
+		gateway CreatePositionGateway
+	}
+)
+
+func NewCreatePositionUseCase(gateway CreatePositionGateway) CreatePositionUseCase {
+	return &createPosition{gateway: gateway}
+}
+
+func (uc *createPosition) Execute(ctx context.Context, position domain.Position) (_ *domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.CreatePosition")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManagePositions); err != nil {
+		return nil, err
+	}
+
+	if err := position.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.gateway.Create(ctx, position)
+}
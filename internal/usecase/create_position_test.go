@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockPositionGateway struct {
+	position *domain.Position
+	created  *domain.Position
+	updated  *domain.Position
+	seeded   []domain.Position
+	deleted  uint
+	err      error
+}
+
+func (m *mockPositionGateway) Create(_ context.Context, p domain.Position) (*domain.Position, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	p.ID = 1
+	m.created = &p
+	return &p, nil
+}
+
+func (m *mockPositionGateway) Get(_ context.Context, id uint) (*domain.Position, error) {
+	if m.position == nil {
+		return nil, apperrors.ErrNotFound
+	}
+	p := *m.position
+	return &p, nil
+}
+
+func (m *mockPositionGateway) Update(_ context.Context, p domain.Position) (*domain.Position, error) {
+	m.updated = &p
+	return &p, m.err
+}
+
+func (m *mockPositionGateway) Delete(_ context.Context, id uint) error {
+	m.deleted = id
+	return m.err
+}
+
+func (m *mockPositionGateway) Seed(_ context.Context, positions []domain.Position) error {
+	m.seeded = positions
+	return m.err
+}
+
+func TestCreatePositionUseCase_Execute_Success(t *testing.T) {
+	gw := &mockPositionGateway{}
+	useCase := NewCreatePositionUseCase(gw)
+
+	got, err := useCase.Execute(asRole(domain.RoleAdmin), *domain.NewPosition("Líbero", "lib", domain.LineDefense))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if got.ID != 1 || gw.created.Abbreviation != "LIB" {
+		t.Errorf("Execute() = %+v", got)
+	}
+}
+
+func TestCreatePositionUseCase_Execute_ValidationError(t *testing.T) {
+	gw := &mockPositionGateway{}
+	useCase := NewCreatePositionUseCase(gw)
+
+	_, err := useCase.Execute(asRole(domain.RoleAdmin), domain.Position{Name: "Líbero"})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want validation error", err)
+	}
+	if gw.created != nil {
+		t.Error("Execute() should not call the gateway for invalid positions")
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	DeletePlayerUseCase interface {
+		Execute(ctx context.Context, id uint) error
+	}
+	DeletePlayerGateway interface {
+		Delete(ctx context.Context, id uint) error
+	}
+	deletePlayer struct {
+		gateway DeletePlayerGateway
+	}
+)
+
+func NewDeletePlayerUseCase(gateway DeletePlayerGateway) DeletePlayerUseCase {
+	return &deletePlayer{gateway: gateway}
+}
+
+func (uc *deletePlayer) Execute(ctx context.Context, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "usecase.DeletePlayer")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManagePlayers); err != nil {
+		return err
+	}
+
+	return uc.gateway.Delete(ctx, id)
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockDeletePlayerGateway struct {
+	gotID uint
+	err   error
+}
+
+func (m *mockDeletePlayerGateway) Delete(_ context.Context, id uint) error {
+	m.gotID = id
+	return m.err
+}
+
+func TestDeletePlayerUseCase_Execute_Success(t *testing.T) {
+	gw := &mockDeletePlayerGateway{}
+	useCase := NewDeletePlayerUseCase(gw)
+
+	if err := useCase.Execute(asRole(domain.RoleOrganiser), 3); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.gotID != 3 {
+		t.Errorf("Execute() gateway id = %d, want 3", gw.gotID)
+	}
+}
+
+func TestDeletePlayerUseCase_Execute_NotFound(t *testing.T) {
+	useCase := NewDeletePlayerUseCase(&mockDeletePlayerGateway{err: apperrors.ErrNotFound})
+
+	if err := useCase.Execute(asRole(domain.RoleOrganiser), 3); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDeletePlayerUseCase_Execute_OwnPlayerForbidden(t *testing.T) {
+	gw := &mockDeletePlayerGateway{}
+	useCase := NewDeletePlayerUseCase(gw)
+
+	if err := useCase.Execute(asPlayer(3), 3); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() own player error = %v, want ErrForbidden", err)
+	}
+	if gw.gotID != 0 {
+		t.Error("Execute() should not let a player delete itself")
+	}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	DeletePositionUseCase interface {
+		Execute(ctx context.Context, id uint) error
+	}
+	DeletePositionGateway interface {
+		Delete(ctx context.Context, id uint) error
+	}
+	deletePosition struct {
+		gateway DeletePositionGateway
+	}
+)
+
+func NewDeletePositionUseCase(gateway DeletePositionGateway) DeletePositionUseCase {
+	return &deletePosition{gateway: gateway}
+}
+
+// Execute remove a posição; o gateway recusa posições em uso por jogadores ativos.
+func (uc *deletePosition) Execute(ctx context.Context, id uint) (err error) {
+	ctx, span := tracing.Start(ctx, "usecase.DeletePosition")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManagePositions); err != nil {
+		return err
+	}
+
+	return uc.gateway.Delete(ctx, id)
+}
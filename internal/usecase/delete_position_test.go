@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+func TestDeletePositionUseCase_Execute(t *testing.T) {
+	gw := &mockPositionGateway{}
+
+	if err := NewDeletePositionUseCase(gw).Execute(asRole(domain.RoleAdmin), 7); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.deleted != 7 {
+		t.Errorf("Execute() gateway id = %d, want 7", gw.deleted)
+	}
+}
+
+func TestDeletePositionUseCase_Execute_InUse(t *testing.T) {
+	var inUse apperrors.ValidationErrors
+	inUse.Append("position", "Position is used by 2 players")
+	gw := &mockPositionGateway{err: &inUse}
+
+	var ve *apperrors.ValidationErrors
+	if err := NewDeletePositionUseCase(gw).Execute(asRole(domain.RoleAdmin), 7); !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want validation error", err)
+	}
+}
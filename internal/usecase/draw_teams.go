@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	DrawTeamsUseCase interface {
+		Execute(context.Context, domain.DrawRequest) (*domain.TeamDraw, error)
+	}
+	DrawTeamsGateway interface {
+		GetMatch(ctx context.Context, id uint) (*domain.Match, error)
+		GetRoster(ctx context.Context, matchID uint) (*domain.Roster, error)
+		GetPlayers(ctx context.Context, ids []uint) ([]domain.Player, error)
+		GetPositions(ctx context.Context) ([]domain.Position, error)
+		SaveMatch(context.Context, domain.Match) (*domain.Match, error)
+	}
+	drawTeams struct {
+		gateway DrawTeamsGateway
+		engine  domain.RatingEngine
+	}
+)
+
+func NewDrawTeamsUseCase(gateway DrawTeamsGateway, engine domain.RatingEngine) DrawTeamsUseCase {
+	return &drawTeams{gateway: gateway, engine: engine}
+}
+
+// Execute sorteia os times da partida. Sem jogadores no pedido, sorteia os confirmados na
+// lista de presença ou, sem nenhum, a escalação atual. Com dois times, o resultado também
+// vira a escalação da partida (mandante e visitante).
+func (uc *drawTeams) Execute(ctx context.Context, req domain.DrawRequest) (_ *domain.TeamDraw, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.DrawTeams")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageMatches); err != nil {
+		return nil, err
+	}
+
+	match, err := uc.gateway.GetMatch(ctx, req.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != domain.MatchScheduled {
+		var errs errors.ValidationErrors
+		errs.Append("match", "Teams can only be drawn for scheduled matches")
+		return nil, &errs
+	}
+
+	if len(req.PlayerIDs) == 0 {
+		roster, err := uc.gateway.GetRoster(ctx, match.ID)
+		if err != nil {
+			return nil, err
+		}
+		req.PlayerIDs = roster.Going()
+	}
+	if len(req.PlayerIDs) == 0 {
+		req.PlayerIDs = append(append([]uint{}, match.HomeTeam.PlayerIDs...), match.AwayTeam.PlayerIDs...)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	players, err := uc.drawnPlayers(ctx, req.PlayerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if req.Teams == 2 {
+		names = []string{match.HomeTeam.Name, match.AwayTeam.Name}
+	}
+
+	draw, err := domain.BalanceTeams(players, req, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Teams == 2 {
+		patch := domain.MatchPatch{
+			HomeTeam: &domain.Team{PlayerIDs: drawnIDs(draw.Teams[0])},
+			AwayTeam: &domain.Team{PlayerIDs: drawnIDs(draw.Teams[1])},
+		}
+		if err := match.Apply(patch); err != nil {
+			return nil, err
+		}
+		if _, err := uc.gateway.SaveMatch(ctx, *match); err != nil {
+			return nil, err
+		}
+	}
+	return draw, nil
+}
+
+func (uc *drawTeams) drawnPlayers(ctx context.Context, ids []uint) ([]domain.DrawnPlayer, error) {
+	players, err := uc.gateway.GetPlayers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := uc.gateway.GetPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	catalog := domain.NewPositionCatalog(positions)
+
+	found := make(map[uint]bool, len(players))
+	drawn := make([]domain.DrawnPlayer, len(players))
+	for i, p := range players {
+		found[p.ID] = true
+		drawn[i] = domain.NewDrawnPlayer(p.ID, p.Name, p.Position, uc.engine.Card(p, nil).Overall, catalog)
+	}
+
+	var errs errors.ValidationErrors
+	for _, id := range ids {
+		if !found[id] {
+			errs.Append("player_ids", fmt.Sprintf("Player %d not found", id))
+		}
+	}
+	if errs.HasErrors() {
+		return nil, &errs
+	}
+	return drawn, nil
+}
+
+func drawnIDs(team domain.DrawnTeam) []uint {
+	ids := make([]uint, len(team.Players))
+	for i, p := range team.Players {
+		ids[i] = p.ID
+	}
+	return ids
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockDrawTeamsGateway struct {
+	match     *domain.Match
+	roster    domain.Roster
+	players   []domain.Player
+	positions []domain.Position
+	saved     *domain.Match
+}
+
+func (m *mockDrawTeamsGateway) GetMatch(_ context.Context, id uint) (*domain.Match, error) {
+	match := *m.match
+	return &match, nil
+}
+
+func (m *mockDrawTeamsGateway) GetRoster(_ context.Context, matchID uint) (*domain.Roster, error) {
+	roster := m.roster
+	return &roster, nil
+}
+
+func (m *mockDrawTeamsGateway) GetPlayers(_ context.Context, ids []uint) ([]domain.Player, error) {
+	wanted := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var players []domain.Player
+	for _, p := range m.players {
+		if wanted[p.ID] {
+			players = append(players, p)
+		}
+	}
+	return players, nil
+}
+
+func (m *mockDrawTeamsGateway) GetPositions(_ context.Context) ([]domain.Position, error) {
+	return m.positions, nil
+}
+
+func (m *mockDrawTeamsGateway) SaveMatch(_ context.Context, match domain.Match) (*domain.Match, error) {
+	m.saved = &match
+	return &match, nil
+}
+
+func drawPlayer(id uint, position string, value int) domain.Player {
+	stats := domain.Stats{Finishing: value, Passing: value, Speed: value, Defense: value, Stamina: value, Highlight: value}
+	return domain.Player{ID: id, Name: position, Position: []string{position}, Stats: stats}
+}
+
+func drawGateway() *mockDrawTeamsGateway {
+	match := newUseCaseMatch()
+	match.ID = 3
+	match.HomeTeam.PlayerIDs = []uint{}
+	match.AwayTeam.PlayerIDs = []uint{}
+	return &mockDrawTeamsGateway{
+		match: &match,
+		players: []domain.Player{
+			drawPlayer(1, "GK", 70), drawPlayer(2, "GK", 75),
+			drawPlayer(3, "CB", 80), drawPlayer(4, "CB", 78),
+			drawPlayer(5, "CM", 85), drawPlayer(6, "CM", 83),
+			drawPlayer(7, "ST", 90), drawPlayer(8, "ST", 88),
+			drawPlayer(9, "LW", 76), drawPlayer(10, "RW", 74),
+		},
+	}
+}
+
+func TestDrawTeamsUseCase_Execute_SavesRosters(t *testing.T) {
+	gw := drawGateway()
+	useCase := NewDrawTeamsUseCase(gw, domain.NewRatingEngine())
+
+	draw, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{
+		MatchID:   3,
+		PlayerIDs: []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		Teams:     2,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if draw.Teams[0].Name != "Azul" || draw.Teams[1].Name != "Branco" {
+		t.Errorf("Execute() team names = %q / %q", draw.Teams[0].Name, draw.Teams[1].Name)
+	}
+	if gw.saved == nil {
+		t.Fatal("Execute() should save the drawn rosters on the match")
+	}
+	if len(gw.saved.HomeTeam.PlayerIDs) != 5 || len(gw.saved.AwayTeam.PlayerIDs) != 5 {
+		t.Errorf("Execute() saved rosters = %v / %v", gw.saved.HomeTeam.PlayerIDs, gw.saved.AwayTeam.PlayerIDs)
+	}
+}
+
+func TestDrawTeamsUseCase_Execute_UsesCatalogLines(t *testing.T) {
+	gw := drawGateway()
+	gw.players[0] = drawPlayer(1, "Arqueiro", 95)
+	gw.players[1] = drawPlayer(2, "Arqueiro", 94)
+	gw.positions = []domain.Position{{Name: "Arqueiro", Abbreviation: "ARQ", Line: domain.LineGoal}}
+	useCase := NewDrawTeamsUseCase(gw, domain.NewRatingEngine())
+
+	draw, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{MatchID: 3, PlayerIDs: []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, Teams: 2})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	for _, team := range draw.Teams {
+		keepers := 0
+		for _, p := range team.Players {
+			if p.Goalkeeper {
+				keepers++
+			}
+		}
+		if keepers != 1 {
+			t.Errorf("Execute() team %q has %d goalkeepers, want 1", team.Name, keepers)
+		}
+	}
+}
+
+func TestDrawTeamsUseCase_Execute_ThreeTeamsDoesNotSave(t *testing.T) {
+	gw := drawGateway()
+	useCase := NewDrawTeamsUseCase(gw, domain.NewRatingEngine())
+
+	draw, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{MatchID: 3, PlayerIDs: []uint{1, 2, 3, 4, 5, 6, 7, 8, 9}, Teams: 3})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(draw.Teams) != 3 || gw.saved != nil {
+		t.Errorf("Execute() teams = %d, saved = %v", len(draw.Teams), gw.saved)
+	}
+}
+
+func TestDrawTeamsUseCase_Execute_UnknownPlayer(t *testing.T) {
+	useCase := NewDrawTeamsUseCase(drawGateway(), domain.NewRatingEngine())
+
+	_, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{MatchID: 3, PlayerIDs: []uint{1, 2, 3, 99}, Teams: 2})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) || (*ve)[0].Message != "Player 99 not found" {
+		t.Fatalf("Execute() error = %v, want Player 99 not found", err)
+	}
+}
+
+func TestDrawTeamsUseCase_Execute_MatchNotScheduled(t *testing.T) {
+	gw := drawGateway()
+	gw.match.Status = domain.MatchCancelled
+	useCase := NewDrawTeamsUseCase(gw, domain.NewRatingEngine())
+
+	_, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{MatchID: 3, PlayerIDs: []uint{1, 2, 3, 4}, Teams: 2})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) || (*ve)[0].Field != "match" {
+		t.Fatalf("Execute() error = %v, want match validation error", err)
+	}
+}
+
+func TestDrawTeamsUseCase_Execute_DefaultsToConfirmedPlayers(t *testing.T) {
+	gw := drawGateway()
+	gw.match.HomeTeam.PlayerIDs = []uint{1, 2}
+	gw.match.AwayTeam.PlayerIDs = []uint{3, 4}
+	for _, id := range []uint{5, 6, 7, 8} {
+		gw.roster.Attendances = append(gw.roster.Attendances, domain.Attendance{PlayerID: id, Status: domain.AttendanceGoing})
+	}
+	gw.roster.Attendances = append(gw.roster.Attendances, domain.Attendance{PlayerID: 9, Status: domain.AttendanceWaitlisted})
+	useCase := NewDrawTeamsUseCase(gw, domain.NewRatingEngine())
+
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{MatchID: 3, Teams: 2}); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	drawn := append(append([]uint{}, gw.saved.HomeTeam.PlayerIDs...), gw.saved.AwayTeam.PlayerIDs...)
+	if len(drawn) != 4 {
+		t.Fatalf("Execute() drew %v, want the 4 confirmed players", drawn)
+	}
+	for _, id := range drawn {
+		if id < 5 || id > 8 {
+			t.Errorf("Execute() drew player %d, want only confirmed players", id)
+		}
+	}
+
+	// Sem confirmados, vale a escalação atual.
+	gw.roster = domain.Roster{}
+	gw.match.HomeTeam.PlayerIDs = []uint{1, 2}
+	gw.match.AwayTeam.PlayerIDs = []uint{3, 4}
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), domain.DrawRequest{MatchID: 3, Teams: 2}); err != nil {
+		t.Fatalf("Execute() lineup error = %v, want nil", err)
+	}
+	if got := len(gw.saved.HomeTeam.PlayerIDs) + len(gw.saved.AwayTeam.PlayerIDs); got != 4 || gw.saved.HomeTeam.PlayerIDs[0] > 4 {
+		t.Errorf("Execute() lineup draw = %v / %v", gw.saved.HomeTeam.PlayerIDs, gw.saved.AwayTeam.PlayerIDs)
+	}
+}
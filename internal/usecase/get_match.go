@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	GetMatchUseCase interface {
+		Execute(ctx context.Context, id uint) (*domain.Match, error)
+	}
+	GetMatchGateway interface {
+		Get(ctx context.Context, id uint) (*domain.Match, error)
+	}
+	getMatch struct {
+		gateway GetMatchGateway
+	}
+)
+
+func NewGetMatchUseCase(gateway GetMatchGateway) GetMatchUseCase {
+	return &getMatch{gateway: gateway}
+}
+
+func (uc *getMatch) Execute(ctx context.Context, id uint) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.GetMatch")
+	defer tracing.End(span, &err)
+	return uc.gateway.Get(ctx, id)
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	GetMembershipUseCase interface {
+		Execute(ctx context.Context, groupID uint) (*domain.Membership, error)
+	}
+	GetMembershipGateway interface {
+		Membership(ctx context.Context, groupID, accountID uint) (*domain.Membership, error)
+		Get(ctx context.Context, groupID uint) (*domain.Group, error)
+	}
+	getMembership struct {
+		gateway GetMembershipGateway
+	}
+)
+
+func NewGetMembershipUseCase(gateway GetMembershipGateway) GetMembershipUseCase {
+	return &getMembership{gateway: gateway}
+}
+
+// Execute resolve o vínculo da conta com o grupo da rota. Quem não é membro recebe
+// ErrNotFound, para não revelar quais grupos existem; o admin da conta entra em qualquer
+// grupo, sem jogador próprio nele.
+func (uc *getMembership) Execute(ctx context.Context, groupID uint) (_ *domain.Membership, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.GetMembership")
+	defer tracing.End(span, &err)
+
+	claims, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErr.ErrUnauthorized
+	}
+
+	membership, err := uc.gateway.Membership(ctx, groupID, claims.AccountID)
+	if !errors.Is(err, appErr.ErrNotFound) || claims.Role != domain.RoleAdmin {
+		return membership, err
+	}
+	if _, err := uc.gateway.Get(ctx, groupID); err != nil {
+		return nil, err
+	}
+	return &domain.Membership{GroupID: groupID, AccountID: claims.AccountID, Role: domain.RoleAdmin}, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+// mockGetMembershipGateway conhece os grupos 1 e 2; a conta 7 é membro só do grupo 1.
+type mockGetMembershipGateway struct{}
+
+func (mockGetMembershipGateway) Membership(_ context.Context, groupID, accountID uint) (*domain.Membership, error) {
+	if groupID != 1 || accountID != 7 {
+		return nil, apperrors.ErrNotFound
+	}
+	return &domain.Membership{GroupID: 1, AccountID: 7, PlayerID: 70, Role: domain.RoleOrganiser}, nil
+}
+
+func (mockGetMembershipGateway) Get(_ context.Context, groupID uint) (*domain.Group, error) {
+	if groupID > 2 {
+		return nil, apperrors.ErrNotFound
+	}
+	return &domain.Group{ID: groupID}, nil
+}
+
+func accountContext(accountID uint, role domain.Role) context.Context {
+	return domain.WithPrincipal(context.Background(), domain.TokenClaims{
+		Principal: domain.Principal{AccountID: accountID, Role: role},
+	})
+}
+
+func TestGetMembershipUseCase_Execute_Member(t *testing.T) {
+	useCase := NewGetMembershipUseCase(mockGetMembershipGateway{})
+
+	m, err := useCase.Execute(accountContext(7, domain.RolePlayer), 1)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if m.PlayerID != 70 || m.Role != domain.RoleOrganiser {
+		t.Errorf("Execute() = %+v", m)
+	}
+}
+
+func TestGetMembershipUseCase_Execute_NotMember(t *testing.T) {
+	useCase := NewGetMembershipUseCase(mockGetMembershipGateway{})
+
+	if _, err := useCase.Execute(accountContext(7, domain.RoleOrganiser), 2); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Errorf("Execute() other group error = %v, want ErrNotFound", err)
+	}
+	if _, err := useCase.Execute(context.Background(), 1); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("Execute() anonymous error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestGetMembershipUseCase_Execute_Admin(t *testing.T) {
+	useCase := NewGetMembershipUseCase(mockGetMembershipGateway{})
+
+	m, err := useCase.Execute(accountContext(8, domain.RoleAdmin), 2)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if m.GroupID != 2 || m.PlayerID != 0 || m.Role != domain.RoleAdmin {
+		t.Errorf("Execute() = %+v, want admin membership without player", m)
+	}
+	if _, err := useCase.Execute(accountContext(8, domain.RoleAdmin), 3); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Errorf("Execute() missing group error = %v, want ErrNotFound", err)
+	}
+}
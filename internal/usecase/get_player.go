@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	GetPlayerUseCase interface {
+		Execute(ctx context.Context, id uint) (*domain.Player, error)
+	}
+	GetPlayerGateway interface {
+		Get(ctx context.Context, id uint) (*domain.Player, error)
+	}
+	getPlayer struct {
+		gateway GetPlayerGateway
+	}
+)
+
+func NewGetPlayerUseCase(gateway GetPlayerGateway) GetPlayerUseCase {
+	return &getPlayer{gateway: gateway}
+}
+
+func (uc *getPlayer) Execute(ctx context.Context, id uint) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.GetPlayer")
+	defer tracing.End(span, &err)
+	return uc.gateway.Get(ctx, id)
+}
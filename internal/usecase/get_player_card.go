@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	GetPlayerCardUseCase interface {
+		Execute(ctx context.Context, playerID uint) (*domain.PlayerCard, error)
+	}
+	GetPlayerCardGateway interface {
+		GetPlayer(ctx context.Context, id uint) (*domain.Player, error)
+		ReceivedRatings(ctx context.Context, playerID uint) ([]domain.Rating, error)
+		Reliability(ctx context.Context, playerID uint) (*domain.Reliability, error)
+	}
+	getPlayerCard struct {
+		gateway GetPlayerCardGateway
+		engine  domain.RatingEngine
+	}
+)
+
+func NewGetPlayerCardUseCase(gateway GetPlayerCardGateway, engine domain.RatingEngine) GetPlayerCardUseCase {
+	return &getPlayerCard{gateway: gateway, engine: engine}
+}
+
+func (uc *getPlayerCard) Execute(ctx context.Context, playerID uint) (_ *domain.PlayerCard, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.GetPlayerCard")
+	defer tracing.End(span, &err)
+
+	player, err := uc.gateway.GetPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := uc.gateway.ReceivedRatings(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	reliability, err := uc.gateway.Reliability(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	card := uc.engine.Card(*player, ratings)
+	card.Reliability = *reliability
+	return &card, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockPlayerCardGateway struct {
+	player      *domain.Player
+	playerErr   error
+	ratings     []domain.Rating
+	reliability domain.Reliability
+	savedStats  map[uint]domain.Stats
+}
+
+func (m *mockPlayerCardGateway) GetPlayer(_ context.Context, id uint) (*domain.Player, error) {
+	if m.playerErr != nil {
+		return nil, m.playerErr
+	}
+	player := *m.player
+	player.ID = id
+	return &player, nil
+}
+
+func (m *mockPlayerCardGateway) ReceivedRatings(_ context.Context, playerID uint) ([]domain.Rating, error) {
+	return m.ratings, nil
+}
+
+func (m *mockPlayerCardGateway) Reliability(_ context.Context, playerID uint) (*domain.Reliability, error) {
+	reliability := m.reliability
+	return &reliability, nil
+}
+
+func (m *mockPlayerCardGateway) SaveStats(_ context.Context, playerID uint, stats domain.Stats) error {
+	if m.savedStats == nil {
+		m.savedStats = make(map[uint]domain.Stats)
+	}
+	m.savedStats[playerID] = stats
+	return nil
+}
+
+func cardRating(value int) domain.Rating {
+	return domain.Rating{
+		Finishing: value, Passing: value, Speed: value,
+		Defense: value, Stamina: value, Highlight: value,
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestGetPlayerCardUseCase_Execute_Success(t *testing.T) {
+	gw := &mockPlayerCardGateway{
+		player:      &domain.Player{Name: "Zico", Position: []string{"CAM"}},
+		ratings:     []domain.Rating{cardRating(80), cardRating(90)},
+		reliability: domain.NewReliability(4, 1),
+	}
+	useCase := NewGetPlayerCardUseCase(gw, domain.NewRatingEngine())
+
+	card, err := useCase.Execute(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if card.PlayerID != 10 || card.RatingsCount != 2 {
+		t.Errorf("Execute() card = %+v", card)
+	}
+	if card.Reliability.Rate != 75 {
+		t.Errorf("Execute() reliability = %+v, want rate 75", card.Reliability)
+	}
+	if card.Overall < 84 || card.Overall > 86 {
+		t.Errorf("Execute() overall = %d, want ~85", card.Overall)
+	}
+}
+
+func TestGetPlayerCardUseCase_Execute_PlayerNotFound(t *testing.T) {
+	useCase := NewGetPlayerCardUseCase(&mockPlayerCardGateway{playerErr: apperrors.ErrNotFound}, domain.NewRatingEngine())
+
+	if _, err := useCase.Execute(context.Background(), 10); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
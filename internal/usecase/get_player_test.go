@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockGetPlayerGateway struct {
+	player *domain.Player
+	err    error
+	gotID  uint
+}
+
+func (m *mockGetPlayerGateway) Get(_ context.Context, id uint) (*domain.Player, error) {
+	m.gotID = id
+	return m.player, m.err
+}
+
+func TestGetPlayerUseCase_Execute_Success(t *testing.T) {
+	gw := &mockGetPlayerGateway{player: &domain.Player{ID: 7, Name: "Zico"}}
+	useCase := NewGetPlayerUseCase(gw)
+
+	result, err := useCase.Execute(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.gotID != 7 {
+		t.Errorf("Execute() gateway id = %d, want 7", gw.gotID)
+	}
+	if result.Name != "Zico" {
+		t.Errorf("Execute() name = %v, want Zico", result.Name)
+	}
+}
+
+func TestGetPlayerUseCase_Execute_NotFound(t *testing.T) {
+	useCase := NewGetPlayerUseCase(&mockGetPlayerGateway{err: apperrors.ErrNotFound})
+
+	result, err := useCase.Execute(context.Background(), 99)
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+	if result != nil {
+		t.Errorf("Execute() result = %v, want nil", result)
+	}
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	GetRosterUseCase interface {
+		Execute(ctx context.Context, matchID uint) (*domain.Roster, error)
+	}
+	GetRosterGateway interface {
+		GetRoster(ctx context.Context, matchID uint) (*domain.Roster, error)
+	}
+	getRoster struct {
+		gateway GetRosterGateway
+	}
+)
+
+func NewGetRosterUseCase(gateway GetRosterGateway) GetRosterUseCase {
+	return &getRoster{gateway: gateway}
+}
+
+func (uc *getRoster) Execute(ctx context.Context, matchID uint) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.GetRoster")
+	defer tracing.End(span, &err)
+	return uc.gateway.GetRoster(ctx, matchID)
+}
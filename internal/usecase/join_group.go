@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	JoinGroupUseCase interface {
+		Execute(context.Context, domain.JoinGroup) (*domain.Group, error)
+	}
+	JoinGroupGateway interface {
+		Join(ctx context.Context, code string, accountID uint, player domain.Player) (*domain.Group, error)
+	}
+	joinGroup struct {
+		gateway JoinGroupGateway
+	}
+)
+
+func NewJoinGroupUseCase(gateway JoinGroupGateway) JoinGroupUseCase {
+	return &joinGroup{gateway: gateway}
+}
+
+// Execute cria o jogador da conta no grupo do código. Código desconhecido é ErrNotFound, e
+// quem já é membro recebe ErrAlreadyExists.
+func (uc *joinGroup) Execute(ctx context.Context, join domain.JoinGroup) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.JoinGroup")
+	defer tracing.End(span, &err)
+
+	claims, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErr.ErrUnauthorized
+	}
+	join.Code = domain.NormalizeJoinCode(join.Code)
+	if err := join.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.gateway.Join(ctx, join.Code, claims.AccountID, join.Player.ToPlayer())
+}
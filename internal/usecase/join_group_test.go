@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockJoinGroupGateway struct {
+	code      string
+	accountID uint
+}
+
+func (m *mockJoinGroupGateway) Join(_ context.Context, code string, accountID uint, _ domain.Player) (*domain.Group, error) {
+	if code != "ABCD2345" {
+		return nil, apperrors.ErrNotFound
+	}
+	m.code, m.accountID = code, accountID
+	return &domain.Group{ID: 1, JoinCode: code}, nil
+}
+
+func TestJoinGroupUseCase_Execute_NormalizesCode(t *testing.T) {
+	gw := &mockJoinGroupGateway{}
+	useCase := NewJoinGroupUseCase(gw)
+
+	_, err := useCase.Execute(asPlayer(7), domain.JoinGroup{
+		Code:   " abcd2345 ",
+		Player: domain.PlayerProfile{Name: "Sócrates", Position: []string{"MC"}},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.code != "ABCD2345" || gw.accountID != 7 {
+		t.Errorf("Execute() joined with code %q, account %d", gw.code, gw.accountID)
+	}
+}
+
+func TestJoinGroupUseCase_Execute_Errors(t *testing.T) {
+	player := domain.PlayerProfile{Name: "Sócrates", Position: []string{"MC"}}
+	useCase := NewJoinGroupUseCase(&mockJoinGroupGateway{})
+
+	if _, err := useCase.Execute(context.Background(), domain.JoinGroup{Code: "ABCD2345", Player: player}); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("Execute() anonymous error = %v, want ErrUnauthorized", err)
+	}
+	if _, err := useCase.Execute(asPlayer(7), domain.JoinGroup{Code: "ZZZZ9999", Player: player}); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Errorf("Execute() unknown code error = %v, want ErrNotFound", err)
+	}
+	var ve *apperrors.ValidationErrors
+	if _, err := useCase.Execute(asPlayer(7), domain.JoinGroup{Code: "  ", Player: player}); !errors.As(err, &ve) {
+		t.Errorf("Execute() blank code error = %v, want *ValidationErrors", err)
+	}
+}
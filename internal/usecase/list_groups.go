@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ListGroupsUseCase interface {
+		Execute(ctx context.Context) ([]domain.Group, error)
+	}
+	ListGroupsGateway interface {
+		ListByAccount(ctx context.Context, accountID uint) ([]domain.Group, error)
+	}
+	listGroups struct {
+		gateway ListGroupsGateway
+	}
+)
+
+func NewListGroupsUseCase(gateway ListGroupsGateway) ListGroupsUseCase {
+	return &listGroups{gateway: gateway}
+}
+
+// Execute lista só os grupos de que a conta é membro, inclusive para admins.
+func (uc *listGroups) Execute(ctx context.Context) (_ []domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ListGroups")
+	defer tracing.End(span, &err)
+
+	claims, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErr.ErrUnauthorized
+	}
+	return uc.gateway.ListByAccount(ctx, claims.AccountID)
+}
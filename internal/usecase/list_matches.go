@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ListMatchesUseCase interface {
+		Execute(ctx context.Context) ([]domain.Match, error)
+	}
+	ListMatchesGateway interface {
+		List(ctx context.Context) ([]domain.Match, error)
+	}
+	listMatches struct {
+		gateway ListMatchesGateway
+	}
+)
+
+func NewListMatchesUseCase(gateway ListMatchesGateway) ListMatchesUseCase {
+	return &listMatches{gateway: gateway}
+}
+
+func (uc *listMatches) Execute(ctx context.Context) (_ []domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ListMatches")
+	defer tracing.End(span, &err)
+	return uc.gateway.List(ctx)
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ListMembersUseCase interface {
+		Execute(ctx context.Context) ([]domain.GroupMember, error)
+	}
+	ListMembersGateway interface {
+		Members(ctx context.Context) ([]domain.GroupMember, error)
+	}
+	listMembers struct {
+		gateway ListMembersGateway
+	}
+)
+
+func NewListMembersUseCase(gateway ListMembersGateway) ListMembersUseCase {
+	return &listMembers{gateway: gateway}
+}
+
+func (uc *listMembers) Execute(ctx context.Context) (_ []domain.GroupMember, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ListMembers")
+	defer tracing.End(span, &err)
+	return uc.gateway.Members(ctx)
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ListPlayersUseCase interface {
+		Execute(context.Context, domain.PageRequest) (*domain.Page[domain.Player], error)
+	}
+	ListPlayersGateway interface {
+		List(context.Context, domain.PageRequest) (*domain.Page[domain.Player], error)
+	}
+	listPlayers struct {
+		gateway ListPlayersGateway
+	}
+)
+
+func NewListPlayersUseCase(gateway ListPlayersGateway) ListPlayersUseCase {
+	return &listPlayers{gateway: gateway}
+}
+
+func (uc *listPlayers) Execute(ctx context.Context, req domain.PageRequest) (_ *domain.Page[domain.Player], err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ListPlayers")
+	defer tracing.End(span, &err)
+	return uc.gateway.List(ctx, req)
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockListPlayersGateway struct {
+	players []domain.Player
+	got     domain.PageRequest
+	err     error
+}
+
+func (m *mockListPlayersGateway) List(_ context.Context, req domain.PageRequest) (*domain.Page[domain.Player], error) {
+	m.got = req
+	if m.err != nil {
+		return nil, m.err
+	}
+	page := domain.NewPage(m.players, int64(len(m.players)), 1, 20)
+	return &page, nil
+}
+
+func TestListPlayersUseCase_Execute_Success(t *testing.T) {
+	gw := &mockListPlayersGateway{players: []domain.Player{{ID: 1, Name: "Zico"}, {ID: 2, Name: "Falcão"}}}
+	useCase := NewListPlayersUseCase(gw)
+
+	req := domain.PageRequest{Page: 1, Sort: "-overall", Filters: map[string]string{"position": "ST"}}
+	result, err := useCase.Execute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(result.Items) != 2 || result.Total != 2 {
+		t.Errorf("Execute() = %+v, want 2 players", result)
+	}
+	if gw.got.Sort != "-overall" || gw.got.Filters["position"] != "ST" {
+		t.Errorf("Execute() passed %+v to the gateway", gw.got)
+	}
+}
+
+func TestListPlayersUseCase_Execute_GatewayError(t *testing.T) {
+	useCase := NewListPlayersUseCase(&mockListPlayersGateway{err: apperrors.ErrDatabase})
+
+	if _, err := useCase.Execute(context.Background(), domain.PageRequest{}); !errors.Is(err, apperrors.ErrDatabase) {
+		t.Fatalf("Execute() error = %v, want ErrDatabase", err)
+	}
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	ListPositionsUseCase interface {
+		Execute(ctx context.Context) ([]domain.Position, error)
+	}
+	ListPositionsGateway interface {
+		List(ctx context.Context) ([]domain.Position, error)
+	}
+	listPositions struct {
+		gateway ListPositionsGateway
+	}
+)
+
+func NewListPositionsUseCase(gateway ListPositionsGateway) ListPositionsUseCase {
+	return &listPositions{gateway: gateway}
+}
+
+func (uc *listPositions) Execute(ctx context.Context) (_ []domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.ListPositions")
+	defer tracing.End(span, &err)
+	return uc.gateway.List(ctx)
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	LoginUseCase interface {
+		Execute(context.Context, domain.Credentials) (*domain.TokenPair, error)
+	}
+	LoginGateway interface {
+		GetByEmail(ctx context.Context, email string) (*domain.Account, error)
+	}
+	login struct {
+		gateway LoginGateway
+		hasher  PasswordHasher
+		tokens  TokenService
+
+		// dummyHash é comparado quando o e-mail não existe, para que a resposta leve o mesmo
+		// tempo e não revele quais e-mails têm conta.
+		dummyOnce sync.Once
+		dummyHash string
+	}
+)
+
+func NewLoginUseCase(gateway LoginGateway, hasher PasswordHasher, tokens TokenService) LoginUseCase {
+	return &login{gateway: gateway, hasher: hasher, tokens: tokens}
+}
+
+// Execute devolve ErrUnauthorized tanto para e-mail desconhecido quanto para senha errada.
+func (uc *login) Execute(ctx context.Context, credentials domain.Credentials) (_ *domain.TokenPair, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.Login")
+	defer tracing.End(span, &err)
+
+	account, err := uc.gateway.GetByEmail(ctx, domain.NormalizeEmail(credentials.Email))
+	if errors.Is(err, appErr.ErrNotFound) {
+		uc.dummyOnce.Do(func() { uc.dummyHash, _ = uc.hasher.Hash("not-a-real-password") })
+		_ = uc.hasher.Compare(uc.dummyHash, credentials.Password)
+		return nil, appErr.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.hasher.Compare(account.PasswordHash, credentials.Password); err != nil {
+		return nil, err
+	}
+	return uc.tokens.Issue(domain.Principal{AccountID: account.ID})
+}
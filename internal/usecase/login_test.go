@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+func TestLoginUseCase_Execute_Success(t *testing.T) {
+	tokens := &fakeTokens{}
+	uc := NewLoginUseCase(newFakeAccountGateway(), fakeHasher{}, tokens)
+
+	pair, err := uc.Execute(context.Background(), domain.Credentials{Email: "ZICO@fut.app", Password: "galinho1"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if pair.AccessToken == "" || len(tokens.issued) != 1 || tokens.issued[0] != (domain.Principal{AccountID: 1}) {
+		t.Errorf("Execute() issued = %+v", tokens.issued)
+	}
+}
+
+func TestLoginUseCase_Execute_Unauthorized(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials domain.Credentials
+	}{
+		{"wrong password", domain.Credentials{Email: "zico@fut.app", Password: "errada"}},
+		{"unknown email", domain.Credentials{Email: "ninguem@fut.app", Password: "galinho1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := &fakeTokens{}
+			uc := NewLoginUseCase(newFakeAccountGateway(), fakeHasher{}, tokens)
+
+			_, err := uc.Execute(context.Background(), tt.credentials)
+			if !errors.Is(err, apperrors.ErrUnauthorized) {
+				t.Fatalf("Execute() error = %v, want ErrUnauthorized", err)
+			}
+			if len(tokens.issued) != 0 {
+				t.Error("Execute() should not issue tokens")
+			}
+		})
+	}
+}
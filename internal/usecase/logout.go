@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	LogoutUseCase interface {
+		Execute(context.Context, domain.Logout) error
+	}
+	LogoutGateway interface {
+		// Revoke devolve ErrAlreadyExists quando o jti já foi revogado.
+		Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	}
+	logout struct {
+		gateway LogoutGateway
+		tokens  TokenService
+	}
+)
+
+func NewLogoutUseCase(gateway LogoutGateway, tokens TokenService) LogoutUseCase {
+	return &logout{gateway: gateway, tokens: tokens}
+}
+
+// Execute revoga o token de acesso e, quando enviado, o refresh token da mesma conta.
+// Revogar de novo um token já revogado não é erro.
+func (uc *logout) Execute(ctx context.Context, req domain.Logout) (err error) {
+	ctx, span := tracing.Start(ctx, "usecase.Logout")
+	defer tracing.End(span, &err)
+
+	revoke := []domain.TokenClaims{req.Access}
+	if req.RefreshToken != "" {
+		refresh, err := uc.tokens.Parse(req.RefreshToken, domain.RefreshToken)
+		if err != nil {
+			return err
+		}
+		if refresh.AccountID != req.Access.AccountID {
+			return appErr.ErrForbidden
+		}
+		revoke = append(revoke, *refresh)
+	}
+
+	for _, claims := range revoke {
+		if err := uc.gateway.Revoke(ctx, claims.ID, claims.ExpiresAt); err != nil && !errors.Is(err, appErr.ErrAlreadyExists) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+func TestLogoutUseCase_Execute_RevokesAccessAndRefresh(t *testing.T) {
+	gw := newFakeAccountGateway()
+	uc := NewLogoutUseCase(gw, &fakeTokens{})
+
+	err := uc.Execute(context.Background(), domain.Logout{Access: fakeTokenClaims["access-1"], RefreshToken: "refresh-1"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !gw.revoked["a1"] || !gw.revoked["r1"] {
+		t.Errorf("Execute() revoked = %v, want a1 and r1", gw.revoked)
+	}
+
+	// Repetir o logout não é erro.
+	if err := uc.Execute(context.Background(), domain.Logout{Access: fakeTokenClaims["access-1"]}); err != nil {
+		t.Errorf("Execute() again error = %v", err)
+	}
+}
+
+func TestLogoutUseCase_Execute_RefreshFromAnotherAccount(t *testing.T) {
+	gw := newFakeAccountGateway()
+	uc := NewLogoutUseCase(gw, &fakeTokens{})
+
+	err := uc.Execute(context.Background(), domain.Logout{Access: fakeTokenClaims["access-1"], RefreshToken: "refresh-2"})
+	if !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() error = %v, want ErrForbidden", err)
+	}
+	if len(gw.revoked) != 0 {
+		t.Errorf("Execute() revoked = %v, want nothing", gw.revoked)
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	// MigratePlayerStatsUseCase converte o JSONB legado de stats para as chaves de
+	// domain.Stats e reporta os jogadores cujos stats não podem ser convertidos.
+	MigratePlayerStatsUseCase interface {
+		Execute(ctx context.Context, dryRun bool) (*domain.StatsMigrationReport, error)
+	}
+	MigratePlayerStatsGateway interface {
+		// EachStoredStats entrega os stats gravados em lotes; um erro de fn interrompe a leitura.
+		EachStoredStats(ctx context.Context, fn func([]domain.StoredStats) error) error
+		SaveStats(ctx context.Context, playerID uint, stats domain.Stats) error
+	}
+	migratePlayerStats struct {
+		gateway MigratePlayerStatsGateway
+	}
+)
+
+func NewMigratePlayerStatsUseCase(gateway MigratePlayerStatsGateway) MigratePlayerStatsUseCase {
+	return &migratePlayerStats{gateway: gateway}
+}
+
+func (uc *migratePlayerStats) Execute(ctx context.Context, dryRun bool) (_ *domain.StatsMigrationReport, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.MigratePlayerStats")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermMigrateStats); err != nil {
+		return nil, err
+	}
+
+	report := &domain.StatsMigrationReport{
+		DryRun:   dryRun,
+		Migrated: []uint{},
+		Invalid:  []domain.InvalidStats{},
+	}
+	err = uc.gateway.EachStoredStats(ctx, func(batch []domain.StoredStats) error {
+		report.Checked += len(batch)
+		for _, s := range batch {
+			if err := uc.migrate(ctx, s, report); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (uc *migratePlayerStats) migrate(ctx context.Context, s domain.StoredStats, report *domain.StatsMigrationReport) error {
+	stats, err := domain.ParseStats(s.Raw)
+	if err != nil {
+		var ve *appErr.ValidationErrors
+		if !errors.As(err, &ve) {
+			return err
+		}
+		report.Invalid = append(report.Invalid, domain.InvalidStats{PlayerID: s.PlayerID, Name: s.Name, Errors: *ve})
+		return nil
+	}
+
+	// Linhas já canônicas são lidas sem perda por StatsFromMap e não precisam ser regravadas.
+	if domain.StatsFromMap(s.Raw) == stats && len(s.Raw) == len(domain.StatNames) {
+		return nil
+	}
+	if !report.DryRun {
+		if err := uc.gateway.SaveStats(ctx, s.PlayerID, stats); err != nil {
+			return err
+		}
+	}
+	report.Migrated = append(report.Migrated, s.PlayerID)
+	return nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+)
+
+type mockMigratePlayerStatsGateway struct {
+	stored []domain.StoredStats
+	saved  map[uint]domain.Stats
+	err    error
+}
+
+// EachStoredStats entrega um jogador por lote, para que o caso de uso junte vários lotes.
+func (m *mockMigratePlayerStatsGateway) EachStoredStats(_ context.Context, fn func([]domain.StoredStats) error) error {
+	if m.err != nil {
+		return m.err
+	}
+	for i := range m.stored {
+		if err := fn(m.stored[i : i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockMigratePlayerStatsGateway) SaveStats(_ context.Context, playerID uint, stats domain.Stats) error {
+	if m.saved == nil {
+		m.saved = make(map[uint]domain.Stats)
+	}
+	m.saved[playerID] = stats
+	return nil
+}
+
+func legacyStatsGateway() *mockMigratePlayerStatsGateway {
+	return &mockMigratePlayerStatsGateway{stored: []domain.StoredStats{
+		{PlayerID: 1, Name: "Zico", Raw: testStats().ToMap()},
+		{PlayerID: 2, Name: "Sócrates", Raw: map[string]interface{}{
+			"finalizacao": 85.0, "passe": 92.0, "velocidade": 70.0,
+			"defesa": 60.0, "resistencia": 75.0, "destaque": 90.0,
+		}},
+		{PlayerID: 3, Name: "Biro-Biro", Raw: map[string]interface{}{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": "x"}},
+	}}
+}
+
+func TestMigratePlayerStatsUseCase_Execute_RewritesLegacyRows(t *testing.T) {
+	gw := legacyStatsGateway()
+	useCase := NewMigratePlayerStatsUseCase(gw)
+
+	report, err := useCase.Execute(asRole(domain.RoleAdmin), false)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if report.Checked != 3 {
+		t.Errorf("Execute() checked = %d, want 3", report.Checked)
+	}
+	if len(report.Migrated) != 1 || report.Migrated[0] != 2 {
+		t.Errorf("Execute() migrated = %v, want [2]", report.Migrated)
+	}
+	if got := gw.saved[2]; got.Passing != 92 || got.Stamina != 75 {
+		t.Errorf("Execute() saved stats = %+v", got)
+	}
+	if _, ok := gw.saved[1]; ok {
+		t.Error("Execute() should not rewrite canonical rows")
+	}
+
+	if len(report.Invalid) != 1 || report.Invalid[0].PlayerID != 3 {
+		t.Fatalf("Execute() invalid = %+v, want player 3", report.Invalid)
+	}
+	if n := len(report.Invalid[0].Errors); n != 12 {
+		t.Errorf("Execute() invalid errors = %d, want 6 unknown keys and 6 missing attributes", n)
+	}
+}
+
+func TestMigratePlayerStatsUseCase_Execute_DryRun(t *testing.T) {
+	gw := legacyStatsGateway()
+	useCase := NewMigratePlayerStatsUseCase(gw)
+
+	report, err := useCase.Execute(asRole(domain.RoleAdmin), true)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if !report.DryRun || len(report.Migrated) != 1 || len(report.Invalid) != 1 {
+		t.Errorf("Execute() report = %+v", report)
+	}
+	if len(gw.saved) != 0 {
+		t.Errorf("Execute() dry run saved %v", gw.saved)
+	}
+}
+
+func TestMigratePlayerStatsUseCase_Execute_GatewayError(t *testing.T) {
+	gw := &mockMigratePlayerStatsGateway{err: errors.New("db down")}
+
+	if _, err := NewMigratePlayerStatsUseCase(gw).Execute(asRole(domain.RoleAdmin), false); err == nil {
+		t.Fatal("Execute() error = nil, want gateway error")
+	}
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	// RecomputePlayerCardsUseCase recalcula a carta dos jogadores e grava os atributos
+	// resultantes em Player.Stats, que passa a ser um dado derivado das notas.
+	RecomputePlayerCardsUseCase interface {
+		Execute(ctx context.Context, playerIDs ...uint) error
+	}
+	RecomputePlayerCardsGateway interface {
+		GetPlayer(ctx context.Context, id uint) (*domain.Player, error)
+		ReceivedRatings(ctx context.Context, playerID uint) ([]domain.Rating, error)
+		SaveStats(ctx context.Context, playerID uint, stats domain.Stats) error
+	}
+	recomputePlayerCards struct {
+		gateway RecomputePlayerCardsGateway
+		engine  domain.RatingEngine
+	}
+)
+
+func NewRecomputePlayerCardsUseCase(gateway RecomputePlayerCardsGateway, engine domain.RatingEngine) RecomputePlayerCardsUseCase {
+	return &recomputePlayerCards{gateway: gateway, engine: engine}
+}
+
+func (uc *recomputePlayerCards) Execute(ctx context.Context, playerIDs ...uint) (err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RecomputePlayerCards")
+	defer tracing.End(span, &err)
+
+	for _, id := range playerIDs {
+		player, err := uc.gateway.GetPlayer(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		ratings, err := uc.gateway.ReceivedRatings(ctx, id)
+		if err != nil {
+			return err
+		}
+		if len(ratings) == 0 {
+			continue
+		}
+
+		card := uc.engine.Card(*player, ratings)
+		if err := uc.gateway.SaveStats(ctx, id, card.Stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"fut-app/internal/domain"
+)
+
+func TestRecomputePlayerCardsUseCase_Execute_SavesDerivedStats(t *testing.T) {
+	gw := &mockPlayerCardGateway{
+		player:  &domain.Player{Name: "Zico", Position: []string{"CAM"}},
+		ratings: []domain.Rating{cardRating(70)},
+	}
+	useCase := NewRecomputePlayerCardsUseCase(gw, domain.NewRatingEngine())
+
+	if err := useCase.Execute(context.Background(), 1, 2); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(gw.savedStats) != 2 {
+		t.Fatalf("Execute() saved stats for %d players, want 2", len(gw.savedStats))
+	}
+	stats := gw.savedStats[1]
+	if stats.Finishing != 70 || stats.Highlight != 70 {
+		t.Errorf("Execute() stats = %v", stats)
+	}
+}
+
+func TestRecomputePlayerCardsUseCase_Execute_SkipsPlayersWithoutRatings(t *testing.T) {
+	gw := &mockPlayerCardGateway{player: &domain.Player{Name: "Zico"}}
+	useCase := NewRecomputePlayerCardsUseCase(gw, domain.NewRatingEngine())
+
+	if err := useCase.Execute(context.Background(), 1); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(gw.savedStats) != 0 {
+		t.Errorf("Execute() should keep registered stats when there are no ratings, saved %v", gw.savedStats)
+	}
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	RecordNoShowsUseCase interface {
+		Execute(ctx context.Context, matchID uint, playerIDs []uint) (*domain.Roster, error)
+	}
+	RecordNoShowsGateway interface {
+		GetMatch(ctx context.Context, id uint) (*domain.Match, error)
+		SetNoShows(ctx context.Context, matchID uint, playerIDs []uint) (*domain.Roster, error)
+	}
+	recordNoShows struct {
+		gateway RecordNoShowsGateway
+	}
+)
+
+func NewRecordNoShowsUseCase(gateway RecordNoShowsGateway) RecordNoShowsUseCase {
+	return &recordNoShows{gateway: gateway}
+}
+
+// Execute substitui a lista de quem confirmou e não apareceu; uma lista vazia limpa as
+// faltas registradas.
+func (uc *recordNoShows) Execute(ctx context.Context, matchID uint, playerIDs []uint) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RecordNoShows")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageMatches); err != nil {
+		return nil, err
+	}
+
+	match, err := uc.gateway.GetMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != domain.MatchFinished {
+		var errs errors.ValidationErrors
+		errs.Append("match", "No-shows can only be recorded for finished matches")
+		return nil, &errs
+	}
+	return uc.gateway.SetNoShows(ctx, matchID, playerIDs)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockRecordNoShowsGateway struct {
+	match   *domain.Match
+	noShows []uint
+}
+
+func (m *mockRecordNoShowsGateway) GetMatch(_ context.Context, id uint) (*domain.Match, error) {
+	match := *m.match
+	return &match, nil
+}
+
+func (m *mockRecordNoShowsGateway) SetNoShows(_ context.Context, matchID uint, playerIDs []uint) (*domain.Roster, error) {
+	m.noShows = playerIDs
+	return &domain.Roster{MatchID: matchID}, nil
+}
+
+func TestRecordNoShowsUseCase_Execute(t *testing.T) {
+	match := newUseCaseMatch()
+	match.Status = domain.MatchFinished
+	gw := &mockRecordNoShowsGateway{match: &match}
+	useCase := NewRecordNoShowsUseCase(gw)
+
+	if _, err := useCase.Execute(asRole(domain.RolePlayer), 3, []uint{2}); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() player error = %v, want ErrForbidden", err)
+	}
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), 3, []uint{2}); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(gw.noShows) != 1 || gw.noShows[0] != 2 {
+		t.Errorf("Execute() no-shows = %v, want [2]", gw.noShows)
+	}
+}
+
+func TestRecordNoShowsUseCase_Execute_MatchNotFinished(t *testing.T) {
+	match := newUseCaseMatch()
+	gw := &mockRecordNoShowsGateway{match: &match}
+	useCase := NewRecordNoShowsUseCase(gw)
+
+	_, err := useCase.Execute(asRole(domain.RoleOrganiser), 3, []uint{2})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) || (*ve)[0].Field != "match" {
+		t.Fatalf("Execute() error = %v, want match validation error", err)
+	}
+	if gw.noShows != nil {
+		t.Error("Execute() should not record no-shows before the match ends")
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fut-app/internal/domain"
+	appErr "fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	RefreshTokenUseCase interface {
+		Execute(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
+	}
+	RefreshTokenGateway interface {
+		Get(ctx context.Context, id uint) (*domain.Account, error)
+		// Revoke devolve ErrAlreadyExists quando o jti já foi revogado.
+		Revoke(ctx context.Context, tokenID string, expiresAt time.Time) error
+	}
+	refreshToken struct {
+		gateway RefreshTokenGateway
+		tokens  TokenService
+	}
+)
+
+func NewRefreshTokenUseCase(gateway RefreshTokenGateway, tokens TokenService) RefreshTokenUseCase {
+	return &refreshToken{gateway: gateway, tokens: tokens}
+}
+
+// Execute troca o refresh token por um par novo. O token usado é revogado antes da emissão,
+// então reaproveitá-lo, ou dois refresh simultâneos com o mesmo token, dá ErrUnauthorized.
+func (uc *refreshToken) Execute(ctx context.Context, token string) (_ *domain.TokenPair, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RefreshToken")
+	defer tracing.End(span, &err)
+
+	claims, err := uc.tokens.Parse(token, domain.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.gateway.Revoke(ctx, claims.ID, claims.ExpiresAt); err != nil {
+		if errors.Is(err, appErr.ErrAlreadyExists) {
+			return nil, appErr.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	account, err := uc.gateway.Get(ctx, claims.AccountID)
+	if errors.Is(err, appErr.ErrNotFound) {
+		return nil, appErr.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+	return uc.tokens.Issue(domain.Principal{AccountID: account.ID})
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "fut-app/internal/errors"
+)
+
+func TestRefreshTokenUseCase_Execute_RotatesToken(t *testing.T) {
+	gw := newFakeAccountGateway()
+	tokens := &fakeTokens{}
+	uc := NewRefreshTokenUseCase(gw, tokens)
+
+	if _, err := uc.Execute(context.Background(), "refresh-1"); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !gw.revoked["r1"] || len(tokens.issued) != 1 {
+		t.Fatalf("Execute() revoked = %v, issued = %v", gw.revoked, tokens.issued)
+	}
+
+	// O refresh token usado não serve uma segunda vez.
+	if _, err := uc.Execute(context.Background(), "refresh-1"); !errors.Is(err, apperrors.ErrUnauthorized) {
+		t.Errorf("Execute() reuse error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestRefreshTokenUseCase_Execute_Unauthorized(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"access token", "access-1"},
+		{"unknown token", "garbage"},
+		{"deleted account", "refresh-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewRefreshTokenUseCase(newFakeAccountGateway(), &fakeTokens{})
+
+			if _, err := uc.Execute(context.Background(), tt.token); !errors.Is(err, apperrors.ErrUnauthorized) {
+				t.Errorf("Execute() error = %v, want ErrUnauthorized", err)
+			}
+		})
+	}
+}
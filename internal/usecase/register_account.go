@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	RegisterAccountUseCase interface {
+		Execute(context.Context, domain.Registration) (*domain.Account, error)
+	}
+	RegisterAccountGateway interface {
+		Create(context.Context, domain.Account) (*domain.Account, error)
+	}
+	registerAccount struct {
+		gateway RegisterAccountGateway
+		hasher  PasswordHasher
+	}
+)
+
+func NewRegisterAccountUseCase(gateway RegisterAccountGateway, hasher PasswordHasher) RegisterAccountUseCase {
+	return &registerAccount{gateway: gateway, hasher: hasher}
+}
+
+func (uc *registerAccount) Execute(ctx context.Context, registration domain.Registration) (_ *domain.Account, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RegisterAccount")
+	defer tracing.End(span, &err)
+
+	registration.Email = domain.NormalizeEmail(registration.Email)
+	if err := registration.Validate(); err != nil {
+		return nil, err
+	}
+
+	hash, err := uc.hasher.Hash(registration.Password)
+	if err != nil {
+		return nil, err
+	}
+	return uc.gateway.Create(ctx, domain.Account{Email: registration.Email, PasswordHash: hash, Role: domain.RolePlayer})
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+func TestRegisterAccountUseCase_Execute_HashesPasswordAndNormalizesEmail(t *testing.T) {
+	gw := newFakeAccountGateway()
+	uc := NewRegisterAccountUseCase(gw, fakeHasher{})
+
+	account, err := uc.Execute(context.Background(), domain.Registration{
+		Email:    " Doutor@Fut.App ",
+		Password: "calcanhar",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if account.Email != "doutor@fut.app" || gw.created.PasswordHash != "hash:calcanhar" || gw.created.Role != domain.RolePlayer {
+		t.Errorf("Execute() created = %+v", gw.created)
+	}
+}
+
+func TestRegisterAccountUseCase_Execute_ValidationError(t *testing.T) {
+	gw := newFakeAccountGateway()
+	uc := NewRegisterAccountUseCase(gw, fakeHasher{})
+
+	_, err := uc.Execute(context.Background(), domain.Registration{
+		Email:    "doutor",
+		Password: "calcanhar",
+	})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want ValidationErrors", err)
+	}
+	if gw.created != nil {
+		t.Error("Execute() should not create an invalid account")
+	}
+}
@@ -1,15 +1,18 @@
 package usecase
 
 import (
+	"context"
+
 	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
 )
 
 type (
 	RegisterPlayerUseCase interface {
-		Execute(domain.Player) (*domain.Player, error)
+		Execute(context.Context, domain.Player) (*domain.Player, error)
 	}
 	RegisterPlayerGateway interface {
-		Register(domain.Player) (*domain.Player, error)
+		Register(context.Context, domain.Player) (*domain.Player, error)
 	}
 	player struct {
 		gateway RegisterPlayerGateway
@@ -20,9 +23,16 @@ func NewPlayerUseCase(gateway RegisterPlayerGateway) RegisterPlayerUseCase {
 	return &player{gateway: gateway}
 }
 
-func (uc *player) Execute(player domain.Player) (*domain.Player, error) {
+func (uc *player) Execute(ctx context.Context, player domain.Player) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RegisterPlayer")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManagePlayers); err != nil {
+		return nil, err
+	}
+
 	if err := player.Validate(); err != nil {
 		return nil, err
 	}
-	return uc.gateway.Register(player)
+	return uc.gateway.Register(ctx, player)
 }
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -15,26 +16,23 @@ type mockRegisterPlayerGateway struct {
 	returnedError     error
 }
 
-func (m *mockRegisterPlayerGateway) Register(player domain.Player) (*domain.Player, error) {
+func (m *mockRegisterPlayerGateway) Register(_ context.Context, player domain.Player) (*domain.Player, error) {
 	if m.shouldReturnError {
 		return nil, m.returnedError
 	}
 	return m.returnedPlayer, nil
 }
 
+func testStats() domain.Stats {
+	return domain.Stats{Finishing: 90, Passing: 88, Speed: 99, Defense: 60, Stamina: 85, Highlight: 95}
+}
+
 func TestRegisterPlayerUseCase_Execute_Success(t *testing.T) {
 	// Arrange
 	expectedPlayer := &domain.Player{
-		ID:   1,
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		ID:       1,
+		Name:     "Pelé",
+		Stats:    testStats(),
 		Position: []string{"Atacante"},
 	}
 
@@ -46,20 +44,13 @@ func TestRegisterPlayerUseCase_Execute_Success(t *testing.T) {
 	useCase := NewPlayerUseCase(mockGateway)
 
 	player := domain.Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    testStats(),
 		Position: []string{"Atacante"},
 	}
 
 	// Act
-	result, err := useCase.Execute(player)
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), player)
 
 	// Assert
 	if err != nil {
@@ -89,20 +80,13 @@ func TestRegisterPlayerUseCase_Execute_ValidationError(t *testing.T) {
 	useCase := NewPlayerUseCase(mockGateway)
 
 	player := domain.Player{
-		Name: "", // Empty name should cause validation error
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "", // Empty name should cause validation error
+		Stats:    testStats(),
 		Position: []string{"Atacante"},
 	}
 
 	// Act
-	result, err := useCase.Execute(player)
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), player)
 
 	// Assert
 	if err == nil {
@@ -136,20 +120,13 @@ func TestRegisterPlayerUseCase_Execute_GatewayError(t *testing.T) {
 	useCase := NewPlayerUseCase(mockGateway)
 
 	player := domain.Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    testStats(),
 		Position: []string{"Atacante"},
 	}
 
 	// Act
-	result, err := useCase.Execute(player)
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), player)
 
 	// Assert
 	if err == nil {
@@ -168,16 +145,9 @@ func TestRegisterPlayerUseCase_Execute_GatewayError(t *testing.T) {
 func TestRegisterPlayerUseCase_Execute_ValidPlayerWithMultiplePositions(t *testing.T) {
 	// Arrange
 	expectedPlayer := &domain.Player{
-		ID:   1,
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		ID:       1,
+		Name:     "Pelé",
+		Stats:    testStats(),
 		Position: []string{"Atacante", "Meio-campo"},
 	}
 
@@ -189,20 +159,13 @@ func TestRegisterPlayerUseCase_Execute_ValidPlayerWithMultiplePositions(t *testi
 	useCase := NewPlayerUseCase(mockGateway)
 
 	player := domain.Player{
-		Name: "Pelé",
-		Stats: map[string]interface{}{
-			"velocidade":  99,
-			"drible":      95,
-			"finalizacao": 90,
-			"passe":       88,
-			"defesa":      60,
-			"fisico":      85,
-		},
+		Name:     "Pelé",
+		Stats:    testStats(),
 		Position: []string{"Atacante", "Meio-campo"},
 	}
 
 	// Act
-	result, err := useCase.Execute(player)
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), player)
 
 	// Assert
 	if err != nil {
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	RotateJoinCodeUseCase interface {
+		Execute(ctx context.Context) (*domain.Group, error)
+	}
+	RotateJoinCodeGateway interface {
+		SetJoinCode(ctx context.Context, code string) (*domain.Group, error)
+	}
+	rotateJoinCode struct {
+		gateway RotateJoinCodeGateway
+	}
+)
+
+func NewRotateJoinCodeUseCase(gateway RotateJoinCodeGateway) RotateJoinCodeUseCase {
+	return &rotateJoinCode{gateway: gateway}
+}
+
+// Execute troca o código de convite do grupo da requisição; o código antigo deixa de valer.
+func (uc *rotateJoinCode) Execute(ctx context.Context) (_ *domain.Group, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RotateJoinCode")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageGroup); err != nil {
+		return nil, err
+	}
+	return withJoinCode(func(code string) (*domain.Group, error) {
+		return uc.gateway.SetJoinCode(ctx, code)
+	})
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockRotateJoinCodeGateway struct {
+	code string
+}
+
+func (m *mockRotateJoinCodeGateway) SetJoinCode(_ context.Context, code string) (*domain.Group, error) {
+	m.code = code
+	return &domain.Group{ID: 1, JoinCode: code}, nil
+}
+
+func TestRotateJoinCodeUseCase_Execute(t *testing.T) {
+	gw := &mockRotateJoinCodeGateway{}
+	useCase := NewRotateJoinCodeUseCase(gw)
+
+	if _, err := useCase.Execute(asPlayer(7)); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() as player error = %v, want ErrForbidden", err)
+	}
+	if gw.code != "" {
+		t.Fatal("Execute() as player should not call gateway")
+	}
+
+	group, err := useCase.Execute(asMember(7, domain.RolePlayer, domain.RoleOrganiser))
+	if err != nil {
+		t.Fatalf("Execute() as group organiser error = %v, want nil", err)
+	}
+	if group.JoinCode != gw.code || len(gw.code) != domain.JoinCodeLength {
+		t.Errorf("Execute() code = %q, saved %q", group.JoinCode, gw.code)
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"fut-app/internal/domain"
+	"fut-app/internal/errors"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	RSVPUseCase interface {
+		Execute(context.Context, domain.RSVP) (*domain.Roster, error)
+	}
+	RSVPGateway interface {
+		GetMatch(ctx context.Context, id uint) (*domain.Match, error)
+		Respond(ctx context.Context, rsvp domain.RSVP, now time.Time) (*domain.Roster, error)
+	}
+	rsvp struct {
+		gateway RSVPGateway
+		now     func() time.Time
+	}
+)
+
+func NewRSVPUseCase(gateway RSVPGateway) RSVPUseCase {
+	return &rsvp{gateway: gateway, now: time.Now}
+}
+
+// Execute responde pelo jogador da conta quando o RSVP não indica outro. Responder por
+// outro jogador exige PermManageMatches.
+func (uc *rsvp) Execute(ctx context.Context, req domain.RSVP) (_ *domain.Roster, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.RSVP")
+	defer tracing.End(span, &err)
+
+	if req.PlayerID == 0 {
+		if m, ok := domain.MembershipFromContext(ctx); ok {
+			req.PlayerID = m.PlayerID
+		}
+	}
+	if req.PlayerID == 0 {
+		var errs errors.ValidationErrors
+		errs.Append("player_id", "player_id is required")
+		return nil, &errs
+	}
+	if err := domain.AuthorizePlayer(ctx, req.PlayerID, domain.PermManageMatches); err != nil {
+		return nil, err
+	}
+
+	match, err := uc.gateway.GetMatch(ctx, req.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if err := match.CheckRSVP(now); err != nil {
+		return nil, err
+	}
+	return uc.gateway.Respond(ctx, req, now)
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockRSVPGateway struct {
+	match     *domain.Match
+	responded *domain.RSVP
+}
+
+func (m *mockRSVPGateway) GetMatch(_ context.Context, id uint) (*domain.Match, error) {
+	match := *m.match
+	return &match, nil
+}
+
+func (m *mockRSVPGateway) Respond(_ context.Context, rsvp domain.RSVP, now time.Time) (*domain.Roster, error) {
+	m.responded = &rsvp
+	return &domain.Roster{MatchID: rsvp.MatchID}, nil
+}
+
+// newRSVPUseCase responde um dia antes do início da partida de newUseCaseMatch.
+func newRSVPUseCase() (*rsvp, *mockRSVPGateway) {
+	match := newUseCaseMatch()
+	match.ID = 3
+	gw := &mockRSVPGateway{match: &match}
+	uc := NewRSVPUseCase(gw).(*rsvp)
+	uc.now = func() time.Time { return match.KickoffAt.Add(-24 * time.Hour) }
+	return uc, gw
+}
+
+func TestRSVPUseCase_Execute_DefaultsToOwnPlayer(t *testing.T) {
+	useCase, gw := newRSVPUseCase()
+
+	if _, err := useCase.Execute(asPlayer(7), domain.RSVP{MatchID: 3, Going: true}); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.responded == nil || gw.responded.PlayerID != 7 || !gw.responded.Going {
+		t.Errorf("Execute() responded = %+v, want player 7 going", gw.responded)
+	}
+}
+
+func TestRSVPUseCase_Execute_OtherPlayerRequiresPermission(t *testing.T) {
+	useCase, gw := newRSVPUseCase()
+
+	if _, err := useCase.Execute(asPlayer(7), domain.RSVP{MatchID: 3, PlayerID: 8, Going: true}); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() error = %v, want ErrForbidden", err)
+	}
+	if _, err := useCase.Execute(asMember(7, domain.RolePlayer, domain.RoleOrganiser), domain.RSVP{MatchID: 3, PlayerID: 8}); err != nil {
+		t.Fatalf("Execute() organiser error = %v, want nil", err)
+	}
+	if gw.responded.PlayerID != 8 || gw.responded.Going {
+		t.Errorf("Execute() responded = %+v, want player 8 declined", gw.responded)
+	}
+}
+
+func TestRSVPUseCase_Execute_AfterDeadline(t *testing.T) {
+	useCase, gw := newRSVPUseCase()
+	deadline := gw.match.KickoffAt.Add(-48 * time.Hour)
+	gw.match.RSVPDeadline = &deadline
+
+	_, err := useCase.Execute(asPlayer(7), domain.RSVP{MatchID: 3, Going: true})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) || (*ve)[0].Field != "rsvp_deadline" {
+		t.Fatalf("Execute() error = %v, want rsvp_deadline validation error", err)
+	}
+	if gw.responded != nil {
+		t.Error("Execute() should not record a late RSVP")
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	// SeedPositionsUseCase garante que o catálogo padrão exista; pode rodar a cada inicialização.
+	SeedPositionsUseCase interface {
+		Execute(ctx context.Context) error
+	}
+	SeedPositionsGateway interface {
+		Seed(context.Context, []domain.Position) error
+	}
+	seedPositions struct {
+		gateway SeedPositionsGateway
+	}
+)
+
+func NewSeedPositionsUseCase(gateway SeedPositionsGateway) SeedPositionsUseCase {
+	return &seedPositions{gateway: gateway}
+}
+
+func (uc *seedPositions) Execute(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "usecase.SeedPositions")
+	defer tracing.End(span, &err)
+	return uc.gateway.Seed(ctx, domain.StandardPositions)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"fut-app/internal/domain"
+)
+
+func TestSeedPositionsUseCase_Execute_SeedsStandardCatalog(t *testing.T) {
+	gw := &mockPositionGateway{}
+
+	if err := NewSeedPositionsUseCase(gw).Execute(context.Background()); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	want := []string{"GK", "CB", "LB", "RB", "CDM", "CM", "CAM", "LW", "RW", "ST"}
+	if len(gw.seeded) != len(want) {
+		t.Fatalf("Execute() seeded %d positions, want %d", len(gw.seeded), len(want))
+	}
+	for i, abbr := range want {
+		if gw.seeded[i].Abbreviation != abbr || gw.seeded[i].Validate() != nil {
+			t.Errorf("Execute() seeded[%d] = %+v, want valid %s", i, gw.seeded[i], abbr)
+		}
+	}
+	if gw.seeded[0].Line != domain.LineGoal {
+		t.Errorf("Execute() GK line = %q, want goal", gw.seeded[0].Line)
+	}
+}
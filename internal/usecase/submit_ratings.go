@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/logger"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	SubmitRatingsUseCase interface {
+		Execute(context.Context, domain.RatingSubmission) ([]domain.Rating, error)
+	}
+	SubmitRatingsGateway interface {
+		GetMatch(ctx context.Context, id uint) (*domain.Match, error)
+		RatedPlayers(ctx context.Context, matchID, raterID uint) ([]uint, error)
+		Save(context.Context, []domain.Rating) ([]domain.Rating, error)
+	}
+	submitRatings struct {
+		gateway SubmitRatingsGateway
+		cards   RecomputePlayerCardsUseCase
+	}
+)
+
+func NewSubmitRatingsUseCase(gateway SubmitRatingsGateway, cards RecomputePlayerCardsUseCase) SubmitRatingsUseCase {
+	return &submitRatings{gateway: gateway, cards: cards}
+}
+
+func (uc *submitRatings) Execute(ctx context.Context, submission domain.RatingSubmission) (_ []domain.Rating, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.SubmitRatings")
+	defer tracing.End(span, &err)
+
+	if err := domain.AuthorizePlayer(ctx, submission.RaterID, domain.PermRateOnBehalf); err != nil {
+		return nil, err
+	}
+
+	match, err := uc.gateway.GetMatch(ctx, submission.MatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	alreadyRated, err := uc.gateway.RatedPlayers(ctx, submission.MatchID, submission.RaterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := submission.Validate(*match, alreadyRated); err != nil {
+		return nil, err
+	}
+
+	ratings := make([]domain.Rating, len(submission.Ratings))
+	ratedIDs := make([]uint, len(submission.Ratings))
+	for i, r := range submission.Ratings {
+		r.MatchID = submission.MatchID
+		r.RaterID = submission.RaterID
+		ratings[i] = r
+		ratedIDs[i] = r.RatedPlayerID
+	}
+
+	saved, err := uc.gateway.Save(ctx, ratings)
+	if err != nil {
+		return nil, err
+	}
+
+	// As notas já estão gravadas: uma falha ao recalcular as cartas não deve rejeitar a
+	// submissão, a carta é recalculada de novo na próxima nota recebida.
+	if err := uc.cards.Execute(context.WithoutCancel(ctx), ratedIDs...); err != nil {
+		logger.FromContext(ctx).Warn("failed to recompute player cards",
+			slog.Uint64("match_id", uint64(submission.MatchID)),
+			slog.String("error", err.Error()),
+		)
+	}
+	return saved, nil
+}
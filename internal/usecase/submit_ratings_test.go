@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockSubmitRatingsGateway struct {
+	match        *domain.Match
+	matchErr     error
+	alreadyRated []uint
+	saved        []domain.Rating
+}
+
+func (m *mockSubmitRatingsGateway) GetMatch(_ context.Context, id uint) (*domain.Match, error) {
+	if m.matchErr != nil {
+		return nil, m.matchErr
+	}
+	return m.match, nil
+}
+
+func (m *mockSubmitRatingsGateway) RatedPlayers(_ context.Context, matchID, raterID uint) ([]uint, error) {
+	return m.alreadyRated, nil
+}
+
+func (m *mockSubmitRatingsGateway) Save(_ context.Context, ratings []domain.Rating) ([]domain.Rating, error) {
+	m.saved = ratings
+	return ratings, nil
+}
+
+type mockRecomputePlayerCardsUseCase struct {
+	ids []uint
+	err error
+}
+
+func (m *mockRecomputePlayerCardsUseCase) Execute(_ context.Context, playerIDs ...uint) error {
+	m.ids = playerIDs
+	return m.err
+}
+
+func finishedUseCaseMatch() *domain.Match {
+	match := newUseCaseMatch()
+	match.ID = 7
+	match.Status = domain.MatchFinished
+	home, away := 3, 3
+	match.HomeTeam.Score = &home
+	match.AwayTeam.Score = &away
+	return &match
+}
+
+func useCaseRating(ratedID uint) domain.Rating {
+	return domain.Rating{RatedPlayerID: ratedID, Finishing: 70, Passing: 70, Speed: 70, Defense: 70, Stamina: 70, Highlight: 70}
+}
+
+func TestSubmitRatingsUseCase_Execute_Success(t *testing.T) {
+	gw := &mockSubmitRatingsGateway{match: finishedUseCaseMatch()}
+	cards := &mockRecomputePlayerCardsUseCase{}
+	useCase := NewSubmitRatingsUseCase(gw, cards)
+
+	result, err := useCase.Execute(asPlayer(1), domain.RatingSubmission{
+		MatchID: 7,
+		RaterID: 1,
+		Ratings: []domain.Rating{useCaseRating(2), useCaseRating(3)},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("Execute() count = %d, want 2", len(result))
+	}
+	for _, r := range gw.saved {
+		if r.MatchID != 7 || r.RaterID != 1 {
+			t.Errorf("Execute() saved rating = %+v, want match 7 rater 1", r)
+		}
+	}
+	if len(cards.ids) != 2 || cards.ids[0] != 2 || cards.ids[1] != 3 {
+		t.Errorf("Execute() recomputed cards = %v, want [2 3]", cards.ids)
+	}
+}
+
+func TestSubmitRatingsUseCase_Execute_RecomputeFailureKeepsRatings(t *testing.T) {
+	gw := &mockSubmitRatingsGateway{match: finishedUseCaseMatch()}
+	useCase := NewSubmitRatingsUseCase(gw, &mockRecomputePlayerCardsUseCase{err: apperrors.ErrDatabase})
+
+	result, err := useCase.Execute(asPlayer(1), domain.RatingSubmission{MatchID: 7, RaterID: 1, Ratings: []domain.Rating{useCaseRating(2)}})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(result) != 1 {
+		t.Errorf("Execute() count = %d, want 1", len(result))
+	}
+}
+
+func TestSubmitRatingsUseCase_Execute_AlreadyRated(t *testing.T) {
+	gw := &mockSubmitRatingsGateway{match: finishedUseCaseMatch(), alreadyRated: []uint{2}}
+	useCase := NewSubmitRatingsUseCase(gw, &mockRecomputePlayerCardsUseCase{})
+
+	_, err := useCase.Execute(asPlayer(1), domain.RatingSubmission{MatchID: 7, RaterID: 1, Ratings: []domain.Rating{useCaseRating(2)}})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want *ValidationErrors", err)
+	}
+	if gw.saved != nil {
+		t.Error("Execute() should not save invalid submission")
+	}
+}
+
+func TestSubmitRatingsUseCase_Execute_MatchNotFound(t *testing.T) {
+	useCase := NewSubmitRatingsUseCase(&mockSubmitRatingsGateway{matchErr: apperrors.ErrNotFound}, &mockRecomputePlayerCardsUseCase{})
+
+	_, err := useCase.Execute(asPlayer(1), domain.RatingSubmission{MatchID: 7, RaterID: 1})
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestSubmitRatingsUseCase_Execute_OnlyAsThemselves(t *testing.T) {
+	gw := &mockSubmitRatingsGateway{match: finishedUseCaseMatch()}
+	useCase := NewSubmitRatingsUseCase(gw, &mockRecomputePlayerCardsUseCase{})
+	submission := domain.RatingSubmission{MatchID: 7, RaterID: 1, Ratings: []domain.Rating{useCaseRating(2)}}
+
+	if _, err := useCase.Execute(asPlayer(3), submission); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() as another player error = %v, want ErrForbidden", err)
+	}
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), submission); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() as organiser error = %v, want ErrForbidden", err)
+	}
+	// O admin pode enviar as notas de um convidado sem conta.
+	if _, err := useCase.Execute(asRole(domain.RoleAdmin), submission); err != nil {
+		t.Fatalf("Execute() as admin error = %v, want nil", err)
+	}
+}
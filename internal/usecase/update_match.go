@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	UpdateMatchUseCase interface {
+		Execute(ctx context.Context, id uint, patch domain.MatchPatch) (*domain.Match, error)
+	}
+	UpdateMatchGateway interface {
+		Get(ctx context.Context, id uint) (*domain.Match, error)
+		// Update falha com ValidationErrors se o status gravado já não for from.
+		Update(ctx context.Context, match domain.Match, from domain.MatchStatus) (*domain.Match, error)
+	}
+	// MatchObserver é avisado das partidas que uma atualização encerrou.
+	MatchObserver interface {
+		MatchFinished(ctx context.Context, match domain.Match)
+	}
+	updateMatch struct {
+		gateway  UpdateMatchGateway
+		observer MatchObserver
+	}
+)
+
+func NewUpdateMatchUseCase(gateway UpdateMatchGateway, observer MatchObserver) UpdateMatchUseCase {
+	return &updateMatch{gateway: gateway, observer: observer}
+}
+
+// Execute aplica o patch sobre a partida atual, recusando transições de status inválidas.
+func (uc *updateMatch) Execute(ctx context.Context, id uint, patch domain.MatchPatch) (_ *domain.Match, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.UpdateMatch")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManageMatches); err != nil {
+		return nil, err
+	}
+
+	match, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	from := match.Status
+	if err := match.Apply(patch); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.gateway.Update(ctx, *match, from)
+	if err != nil {
+		return nil, err
+	}
+	// Um patch pode repetir o status finished junto com o placar; só a transição conta.
+	if from != domain.MatchFinished && updated.Status == domain.MatchFinished {
+		uc.observer.MatchFinished(ctx, *updated)
+	}
+	return updated, nil
+}
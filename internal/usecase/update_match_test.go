@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type (
+	mockUpdateMatchGateway struct {
+		current *domain.Match
+		getErr  error
+		updated *domain.Match
+		from    domain.MatchStatus
+	}
+
+	recordingMatchObserver struct {
+		finished []uint
+	}
+)
+
+func (m *mockUpdateMatchGateway) Get(_ context.Context, id uint) (*domain.Match, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	match := *m.current
+	return &match, nil
+}
+
+func (m *mockUpdateMatchGateway) Update(_ context.Context, match domain.Match, from domain.MatchStatus) (*domain.Match, error) {
+	m.updated = &match
+	m.from = from
+	return &match, nil
+}
+
+func (o *recordingMatchObserver) MatchFinished(_ context.Context, match domain.Match) {
+	o.finished = append(o.finished, match.ID)
+}
+
+func TestUpdateMatchUseCase_Execute_LegalTransition(t *testing.T) {
+	current := newUseCaseMatch()
+	current.ID = 5
+	gw := &mockUpdateMatchGateway{current: &current}
+	useCase := NewUpdateMatchUseCase(gw, &recordingMatchObserver{})
+
+	status := domain.MatchInProgress
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), 5, domain.MatchPatch{Status: &status})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if result.Status != domain.MatchInProgress || gw.updated == nil || gw.from != domain.MatchScheduled {
+		t.Errorf("Execute() result = %+v, from = %q", result, gw.from)
+	}
+}
+
+func TestUpdateMatchUseCase_Execute_NotifiesFinishedOnce(t *testing.T) {
+	current := newUseCaseMatch()
+	current.ID = 5
+	current.Status = domain.MatchInProgress
+	home, away := 2, 1
+	current.HomeTeam.Score, current.AwayTeam.Score = &home, &away
+	gw := &mockUpdateMatchGateway{current: &current}
+	observer := &recordingMatchObserver{}
+	useCase := NewUpdateMatchUseCase(gw, observer)
+
+	status := domain.MatchFinished
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), 5, domain.MatchPatch{Status: &status}); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	// Repetir o status finished junto com o placar não encerra a partida de novo.
+	current.Status = domain.MatchFinished
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), 5, domain.MatchPatch{Status: &status}); err != nil {
+		t.Fatalf("Execute() again error = %v, want nil", err)
+	}
+	if len(observer.finished) != 1 || observer.finished[0] != 5 {
+		t.Errorf("MatchFinished() calls = %v, want one for match 5", observer.finished)
+	}
+}
+
+func TestUpdateMatchUseCase_Execute_IllegalTransition(t *testing.T) {
+	current := newUseCaseMatch()
+	current.Status = domain.MatchCancelled
+	gw := &mockUpdateMatchGateway{current: &current}
+	useCase := NewUpdateMatchUseCase(gw, &recordingMatchObserver{})
+
+	status := domain.MatchInProgress
+	_, err := useCase.Execute(asRole(domain.RoleOrganiser), 5, domain.MatchPatch{Status: &status})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want *ValidationErrors", err)
+	}
+	if gw.updated != nil {
+		t.Error("Execute() should not update on illegal transition")
+	}
+}
+
+func TestUpdateMatchUseCase_Execute_NotFound(t *testing.T) {
+	useCase := NewUpdateMatchUseCase(&mockUpdateMatchGateway{getErr: apperrors.ErrNotFound}, &recordingMatchObserver{})
+
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), 5, domain.MatchPatch{}); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	UpdatePlayerUseCase interface {
+		Execute(ctx context.Context, id uint, update domain.PlayerUpdate) (*domain.Player, error)
+	}
+	UpdatePlayerGateway interface {
+		Update(ctx context.Context, id uint, update domain.PlayerUpdate) (*domain.Player, error)
+	}
+	updatePlayer struct {
+		gateway UpdatePlayerGateway
+	}
+)
+
+func NewUpdatePlayerUseCase(gateway UpdatePlayerGateway) UpdatePlayerUseCase {
+	return &updatePlayer{gateway: gateway}
+}
+
+// Execute deixa o jogador editar o próprio perfil; sobrescrever stats, que vêm das notas e
+// pesam no sorteio, exige PermManagePlayers mesmo sobre o próprio jogador.
+func (uc *updatePlayer) Execute(ctx context.Context, id uint, update domain.PlayerUpdate) (_ *domain.Player, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.UpdatePlayer")
+	defer tracing.End(span, &err)
+
+	if update.Stats != nil {
+		err = domain.Authorize(ctx, domain.PermManagePlayers)
+	} else {
+		err = domain.AuthorizePlayer(ctx, id, domain.PermManagePlayers)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := update.Validate(); err != nil {
+		return nil, err
+	}
+	return uc.gateway.Update(ctx, id, update)
+}
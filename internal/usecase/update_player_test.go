@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+type mockUpdatePlayerGateway struct {
+	called bool
+	gotID  uint
+	got    domain.PlayerUpdate
+	err    error
+}
+
+func (m *mockUpdatePlayerGateway) Update(_ context.Context, id uint, update domain.PlayerUpdate) (*domain.Player, error) {
+	m.called = true
+	m.gotID = id
+	m.got = update
+	if m.err != nil {
+		return nil, m.err
+	}
+	player := domain.Player{ID: id, Name: update.Name, Position: update.Position}
+	if update.Stats != nil {
+		player.Stats = *update.Stats
+	}
+	return &player, nil
+}
+
+func validUpdatePlayer() domain.PlayerUpdate {
+	return domain.PlayerUpdate{
+		Name:     "Romário",
+		Stats:    &domain.Stats{Finishing: 97, Passing: 80, Speed: 92, Defense: 45, Stamina: 70, Highlight: 95},
+		Position: []string{"Atacante"},
+	}
+}
+
+func TestUpdatePlayerUseCase_Execute_Success(t *testing.T) {
+	gw := &mockUpdatePlayerGateway{}
+	useCase := NewUpdatePlayerUseCase(gw)
+
+	result, err := useCase.Execute(asRole(domain.RoleOrganiser), 11, validUpdatePlayer())
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if gw.gotID != 11 {
+		t.Errorf("Execute() gateway id = %d, want 11", gw.gotID)
+	}
+	if result.Name != "Romário" {
+		t.Errorf("Execute() name = %v, want Romário", result.Name)
+	}
+}
+
+func TestUpdatePlayerUseCase_Execute_ValidationError(t *testing.T) {
+	gw := &mockUpdatePlayerGateway{}
+	useCase := NewUpdatePlayerUseCase(gw)
+
+	player := validUpdatePlayer()
+	player.Name = ""
+
+	_, err := useCase.Execute(asRole(domain.RoleOrganiser), 11, player)
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Fatalf("Execute() error = %v, want *ValidationErrors", err)
+	}
+	if gw.called {
+		t.Error("Execute() should not call gateway when validation fails")
+	}
+}
+
+func TestUpdatePlayerUseCase_Execute_NotFound(t *testing.T) {
+	useCase := NewUpdatePlayerUseCase(&mockUpdatePlayerGateway{err: apperrors.ErrNotFound})
+
+	if _, err := useCase.Execute(asRole(domain.RoleOrganiser), 11, validUpdatePlayer()); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUpdatePlayerUseCase_Execute_OnlyOwnProfile(t *testing.T) {
+	gw := &mockUpdatePlayerGateway{}
+	useCase := NewUpdatePlayerUseCase(gw)
+
+	profile := validUpdatePlayer()
+	profile.Stats = nil
+	if _, err := useCase.Execute(asPlayer(11), 11, profile); err != nil {
+		t.Fatalf("Execute() own profile error = %v, want nil", err)
+	}
+
+	gw.called = false
+	if _, err := useCase.Execute(asPlayer(12), 11, profile); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() other profile error = %v, want ErrForbidden", err)
+	}
+	if gw.called {
+		t.Error("Execute() should not call gateway for another player's profile")
+	}
+}
+
+func TestUpdatePlayerUseCase_Execute_OwnStatsForbidden(t *testing.T) {
+	gw := &mockUpdatePlayerGateway{}
+	useCase := NewUpdatePlayerUseCase(gw)
+
+	if _, err := useCase.Execute(asPlayer(11), 11, validUpdatePlayer()); !errors.Is(err, apperrors.ErrForbidden) {
+		t.Fatalf("Execute() own stats error = %v, want ErrForbidden", err)
+	}
+	if gw.called {
+		t.Error("Execute() should not let a player overwrite its own stats")
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"fut-app/internal/domain"
+	"fut-app/pkg/tracing"
+)
+
+type (
+	UpdatePositionUseCase interface {
+		Execute(ctx context.Context, id uint, patch domain.PositionPatch) (*domain.Position, error)
+	}
+	UpdatePositionGateway interface {
+		Get(ctx context.Context, id uint) (*domain.Position, error)
+		Update(context.Context, domain.Position) (*domain.Position, error)
+	}
+	updatePosition struct {
+		gateway UpdatePositionGateway
+	}
+)
+
+func NewUpdatePositionUseCase(gateway UpdatePositionGateway) UpdatePositionUseCase {
+	return &updatePosition{gateway: gateway}
+}
+
+// Execute renomeia a posição ou troca sua sigla ou setor. Como os jogadores guardam a
+// referência pelo id, a mudança vale para todos que já usam a posição.
+func (uc *updatePosition) Execute(ctx context.Context, id uint, patch domain.PositionPatch) (_ *domain.Position, err error) {
+	ctx, span := tracing.Start(ctx, "usecase.UpdatePosition")
+	defer tracing.End(span, &err)
+
+	if err := domain.Authorize(ctx, domain.PermManagePositions); err != nil {
+		return nil, err
+	}
+
+	position, err := uc.gateway.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := position.Apply(patch); err != nil {
+		return nil, err
+	}
+	return uc.gateway.Update(ctx, *position)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"fut-app/internal/domain"
+	apperrors "fut-app/internal/errors"
+)
+
+func TestUpdatePositionUseCase_Execute_Rename(t *testing.T) {
+	gw := &mockPositionGateway{position: &domain.Position{ID: 4, Name: "Volante", Abbreviation: "CDM", Line: domain.LineMidfield}}
+	useCase := NewUpdatePositionUseCase(gw)
+	name := "Primeiro volante"
+
+	got, err := useCase.Execute(asRole(domain.RoleAdmin), 4, domain.PositionPatch{Name: &name})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if got.Name != name || got.Abbreviation != "CDM" || gw.updated == nil {
+		t.Errorf("Execute() = %+v", got)
+	}
+}
+
+func TestUpdatePositionUseCase_Execute_InvalidLine(t *testing.T) {
+	gw := &mockPositionGateway{position: &domain.Position{ID: 4, Name: "Volante", Abbreviation: "CDM", Line: domain.LineMidfield}}
+	useCase := NewUpdatePositionUseCase(gw)
+	line := domain.Line("bench")
+
+	_, err := useCase.Execute(asRole(domain.RoleAdmin), 4, domain.PositionPatch{Line: &line})
+	var ve *apperrors.ValidationErrors
+	if !errors.As(err, &ve) || gw.updated != nil {
+		t.Fatalf("Execute() error = %v, want validation error without update", err)
+	}
+}
+
+func TestUpdatePositionUseCase_Execute_NotFound(t *testing.T) {
+	useCase := NewUpdatePositionUseCase(&mockPositionGateway{})
+
+	if _, err := useCase.Execute(asRole(domain.RoleAdmin), 9, domain.PositionPatch{}); !errors.Is(err, apperrors.ErrNotFound) {
+		t.Fatalf("Execute() error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,37 @@
+// Package env lê a configuração das variáveis de ambiente com valores padrão.
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// String devolve a variável key, ou defaultValue se ela estiver vazia.
+func String(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// Int devolve a variável key como inteiro, ou defaultValue se ela estiver vazia ou inválida.
+func Int(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if i, err := strconv.Atoi(value); err == nil {
+			return i
+		}
+	}
+	return defaultValue
+}
+
+// Duration devolve a variável key no formato de time.ParseDuration, ou defaultValue se
+// ela estiver vazia ou inválida.
+func Duration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
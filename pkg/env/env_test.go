@@ -0,0 +1,54 @@
+package env
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	t.Setenv("ENV_TEST_STRING", "")
+	assert.Equal(t, "default", String("ENV_TEST_STRING", "default"))
+
+	t.Setenv("ENV_TEST_STRING", "value")
+	assert.Equal(t, "value", String("ENV_TEST_STRING", "default"))
+}
+
+func TestInt(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "empty uses default", value: "", want: 7},
+		{name: "valid value", value: "42", want: 42},
+		{name: "invalid value uses default", value: "many", want: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_TEST_INT", tt.value)
+			assert.Equal(t, tt.want, Int("ENV_TEST_INT", 7))
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty uses default", value: "", want: time.Second},
+		{name: "valid value", value: "90s", want: 90 * time.Second},
+		{name: "invalid value uses default", value: "90", want: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV_TEST_DURATION", tt.value)
+			assert.Equal(t, tt.want, Duration("ENV_TEST_DURATION", time.Second))
+		})
+	}
+}
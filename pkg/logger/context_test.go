@@ -0,0 +1,21 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	requestLogger := slog.New(slog.DiscardHandler).With(slog.String("request_id", "abc"))
+	fallback := slog.New(slog.DiscardHandler)
+
+	ctx := WithContext(context.Background(), requestLogger)
+
+	assert.Same(t, requestLogger, FromContext(ctx))
+	assert.Same(t, requestLogger, FromContextOr(ctx, fallback))
+	assert.Same(t, fallback, FromContextOr(context.Background(), fallback))
+	assert.Same(t, slog.Default(), FromContext(context.Background()))
+}
@@ -0,0 +1,87 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// redactAttr troca por [REDACTED] o valor de qualquer atributo cujo nome esteja em keys,
+// inclusive dentro de grupos.
+func redactAttr(keys map[string]bool) func(groups []string, a slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if keys[strings.ToLower(a.Key)] {
+			return slog.String(a.Key, redacted)
+		}
+		return a
+	}
+}
+
+// samplingHandler deixa passar as primeiras initial mensagens iguais (mesmo nível e texto)
+// de cada segundo e depois uma a cada thereafter. Warn e Error nunca são descartados.
+type samplingHandler struct {
+	next       slog.Handler
+	initial    int
+	thereafter int
+	state      *sampleState
+}
+
+type sampleState struct {
+	mu     sync.Mutex
+	window time.Time
+	counts map[sampleKey]int
+	now    func() time.Time
+}
+
+type sampleKey struct {
+	level slog.Level
+	msg   string
+}
+
+func newSamplingHandler(next slog.Handler, initial, thereafter int) *samplingHandler {
+	return &samplingHandler{
+		next:       next,
+		initial:    initial,
+		thereafter: thereafter,
+		state:      &sampleState{counts: make(map[sampleKey]int), now: time.Now},
+	}
+}
+
+func (h *samplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *samplingHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelWarn || h.state.allow(sampleKey{r.Level, r.Message}, h.initial, h.thereafter) {
+		return h.next.Handle(ctx, r)
+	}
+	return nil
+}
+
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &samplingHandler{next: h.next.WithAttrs(attrs), initial: h.initial, thereafter: h.thereafter, state: h.state}
+}
+
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+	return &samplingHandler{next: h.next.WithGroup(name), initial: h.initial, thereafter: h.thereafter, state: h.state}
+}
+
+func (s *sampleState) allow(key sampleKey, initial, thereafter int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if window := s.now().Truncate(time.Second); !window.Equal(s.window) {
+		s.window = window
+		clear(s.counts)
+	}
+	s.counts[key]++
+	n := s.counts[key]
+	if n <= initial {
+		return true
+	}
+	return thereafter > 0 && (n-initial)%thereafter == 0
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactAttr(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: redactAttr(map[string]bool{"password": true, "authorization": true}),
+	}))
+
+	logger.Info("login", slog.String("user", "zico"), slog.String("Password", "hunter2"),
+		slog.Group("headers", slog.String("authorization", "Bearer abc")))
+
+	out := buf.String()
+	assert.NotContains(t, out, "hunter2")
+	assert.NotContains(t, out, "Bearer abc")
+	assert.Contains(t, out, "Password="+redacted)
+	assert.Contains(t, out, "headers.authorization="+redacted)
+	assert.Contains(t, out, "user=zico")
+}
+
+func TestSamplingHandler(t *testing.T) {
+	var buf bytes.Buffer
+	h := newSamplingHandler(slog.NewTextHandler(&buf, nil), 2, 3)
+	now := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	h.state.now = func() time.Time { return now }
+	logger := slog.New(h).With(slog.String("app", "fut-app"))
+
+	for range 8 {
+		logger.Info("query")
+	}
+	// 2 iniciais e depois a 5ª e a 8ª (uma a cada 3).
+	assert.Equal(t, 4, strings.Count(buf.String(), "msg=query"))
+
+	buf.Reset()
+	for range 3 {
+		logger.Error("query")
+	}
+	assert.Equal(t, 3, strings.Count(buf.String(), "msg=query"), "errors are never sampled")
+
+	buf.Reset()
+	now = now.Add(time.Second)
+	logger.Info("query")
+	assert.Equal(t, 1, strings.Count(buf.String(), "msg=query"), "a new second resets the counters")
+}
+
+func TestSamplingHandler_Enabled(t *testing.T) {
+	h := newSamplingHandler(slog.NewTextHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelWarn}), 1, 1)
+	assert.False(t, h.Enabled(context.Background(), slog.LevelInfo))
+	assert.True(t, h.Enabled(context.Background(), slog.LevelError))
+}
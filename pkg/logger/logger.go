@@ -1,31 +1,127 @@
 package logger
 
 import (
+	"io"
 	"log/slog"
 	"os"
+	"strings"
+
+	"fut-app/pkg/env"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+
+	OutputStdout = "stdout"
+	OutputStderr = "stderr"
 )
 
+// defaultRedactKeys são os atributos que nunca devem aparecer em claro nos logs.
+var defaultRedactKeys = []string{"password", "token", "access_token", "refresh_token", "secret", "authorization", "cookie"}
+
 type Config struct {
 	AppName string
+	// Level é o nível mínimo registrado; o zero é slog.LevelInfo.
+	Level slog.Level
+	// Format é FormatJSON ou FormatText; vazio escolhe texto em local/development.
+	Format string
+	// Output é OutputStdout (padrão), OutputStderr ou o caminho de um arquivo.
+	Output string
+	// MaxSizeMB e MaxBackups controlam a rotação quando Output é um arquivo.
+	MaxSizeMB  int
+	MaxBackups int
+	// RedactKeys são nomes de atributos cujo valor vira [REDACTED], sem diferenciar maiúsculas.
+	RedactKeys []string
+	// SampleInitial > 0 liga a amostragem: por segundo, as primeiras SampleInitial mensagens
+	// iguais passam e depois uma a cada SampleThereafter. Warn e Error sempre passam.
+	SampleInitial    int
+	SampleThereafter int
+}
+
+// ConfigFromEnv lê LOG_LEVEL, LOG_FORMAT, LOG_OUTPUT, LOG_MAX_SIZE_MB, LOG_MAX_BACKUPS,
+// LOG_REDACT_KEYS, LOG_SAMPLE_INITIAL e LOG_SAMPLE_THEREAFTER.
+func ConfigFromEnv(appName string) Config {
+	cfg := Config{
+		AppName:          appName,
+		Level:            parseLevel(os.Getenv("LOG_LEVEL")),
+		Format:           strings.ToLower(os.Getenv("LOG_FORMAT")),
+		Output:           env.String("LOG_OUTPUT", OutputStdout),
+		MaxSizeMB:        env.Int("LOG_MAX_SIZE_MB", 100),
+		MaxBackups:       env.Int("LOG_MAX_BACKUPS", 5),
+		RedactKeys:       defaultRedactKeys,
+		SampleInitial:    env.Int("LOG_SAMPLE_INITIAL", 0),
+		SampleThereafter: env.Int("LOG_SAMPLE_THEREAFTER", 100),
+	}
+	if keys := os.Getenv("LOG_REDACT_KEYS"); keys != "" {
+		cfg.RedactKeys = strings.Split(keys, ",")
+	}
+	return cfg
 }
 
 func NewLogger(cfg Config) *slog.Logger {
-	env := os.Getenv("APP_ENV")
-	level := slog.LevelInfo
+	appEnv := os.Getenv("APP_ENV")
+
+	out, openErr := openOutput(cfg)
+
+	keys := make(map[string]bool, len(cfg.RedactKeys))
+	for _, key := range cfg.RedactKeys {
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			keys[key] = true
+		}
+	}
+	opts := &slog.HandlerOptions{Level: cfg.Level}
+	if len(keys) > 0 {
+		opts.ReplaceAttr = redactAttr(keys)
+	}
+
+	format := cfg.Format
+	if format == "" {
+		format = FormatJSON
+		if appEnv == "local" || appEnv == "development" {
+			format = FormatText
+		}
+	}
 
 	var handler slog.Handler
-	if env == "local" || env == "development" {
-		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level: level,
-		})
+	if format == FormatText {
+		handler = slog.NewTextHandler(out, opts)
 	} else {
-		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level: level,
-		})
+		handler = slog.NewJSONHandler(out, opts)
+	}
+	if cfg.SampleInitial > 0 {
+		handler = newSamplingHandler(handler, cfg.SampleInitial, cfg.SampleThereafter)
 	}
 
-	return slog.New(handler).With(
+	logger := slog.New(handler).With(
 		slog.String("app", cfg.AppName),
-		slog.String("env", env),
+		slog.String("env", appEnv),
 	)
+	if openErr != nil {
+		logger.Error("❌ Failed to open log output, writing to stdout", slog.String("output", cfg.Output), slog.String("error", openErr.Error()))
+	}
+	return logger
+}
+
+// openOutput devolve o destino dos logs; se o arquivo não abrir, cai para o stdout.
+func openOutput(cfg Config) (io.Writer, error) {
+	switch cfg.Output {
+	case "", OutputStdout:
+		return os.Stdout, nil
+	case OutputStderr:
+		return os.Stderr, nil
+	}
+	file, err := openRotatingFile(cfg.Output, cfg.MaxSizeMB, cfg.MaxBackups)
+	if err != nil {
+		return os.Stdout, err
+	}
+	return file, nil
+}
+
+func parseLevel(value string) slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(value)); err != nil {
+		return slog.LevelInfo
+	}
+	return level
 }
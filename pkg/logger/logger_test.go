@@ -156,3 +156,52 @@ func TestNewLogger_HandlerOptionsLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "debug")
+	t.Setenv("LOG_FORMAT", "TEXT")
+	t.Setenv("LOG_OUTPUT", "/var/log/fut-app.log")
+	t.Setenv("LOG_REDACT_KEYS", "password,cpf")
+	t.Setenv("LOG_SAMPLE_INITIAL", "10")
+
+	cfg := ConfigFromEnv("fut-app")
+
+	assert.Equal(t, slog.LevelDebug, cfg.Level)
+	assert.Equal(t, FormatText, cfg.Format)
+	assert.Equal(t, "/var/log/fut-app.log", cfg.Output)
+	assert.Equal(t, []string{"password", "cpf"}, cfg.RedactKeys)
+	assert.Equal(t, 10, cfg.SampleInitial)
+	assert.Equal(t, 100, cfg.SampleThereafter)
+}
+
+func TestConfigFromEnv_Defaults(t *testing.T) {
+	t.Setenv("LOG_LEVEL", "loud")
+
+	cfg := ConfigFromEnv("fut-app")
+
+	assert.Equal(t, slog.LevelInfo, cfg.Level)
+	assert.Equal(t, OutputStdout, cfg.Output)
+	assert.Contains(t, cfg.RedactKeys, "password")
+	assert.Zero(t, cfg.SampleInitial)
+}
+
+func TestNewLogger_FileOutput(t *testing.T) {
+	path := t.TempDir() + "/fut-app.log"
+	logger := NewLogger(Config{
+		AppName:    "fut-app",
+		Level:      slog.LevelWarn,
+		Format:     FormatJSON,
+		Output:     path,
+		RedactKeys: []string{"token"},
+	})
+
+	logger.Info("ignored below the level")
+	logger.Warn("login failed", slog.String("token", "abc123"))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "ignored below the level")
+	assert.Contains(t, string(content), `"msg":"login failed"`)
+	assert.Contains(t, string(content), `"token":"[REDACTED]"`)
+	assert.NotContains(t, string(content), "abc123")
+}
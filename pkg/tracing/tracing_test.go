@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestConfigFromEnv_Defaults(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "")
+	t.Setenv("OTEL_SERVICE_NAME", "")
+	t.Setenv("OTEL_TRACES_SAMPLE_RATIO", "")
+
+	cfg := ConfigFromEnv("fut-app")
+
+	assert.Equal(t, Config{ServiceName: "fut-app", Exporter: ExporterNone, SampleRatio: 1}, cfg)
+}
+
+func TestConfigFromEnv_Overrides(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "OTLP")
+	t.Setenv("OTEL_SERVICE_NAME", "fut-api")
+	t.Setenv("OTEL_TRACES_SAMPLE_RATIO", "0.25")
+
+	cfg := ConfigFromEnv("fut-app")
+
+	assert.Equal(t, Config{ServiceName: "fut-api", Exporter: ExporterOTLP, SampleRatio: 0.25}, cfg)
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	_, err := Setup(context.Background(), Config{Exporter: "zipkin"})
+
+	assert.ErrorIs(t, err, ErrUnknownExporter)
+}
+
+func TestSetup_NoneIsNoop(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Config{Exporter: ExporterNone})
+	require.NoError(t, err)
+
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func useRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestStartEnd_RecordsError(t *testing.T) {
+	recorder := useRecorder(t)
+
+	ctx, parent := Start(context.Background(), "usecase.RegisterPlayer")
+	func() (err error) {
+		_, span := Start(ctx, "gateway.RegisterPlayer.Register")
+		defer End(span, &err)
+		return errors.New("boom")
+	}()
+	var ok error
+	End(parent, &ok)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	child, root := spans[0], spans[1]
+	assert.Equal(t, "gateway.RegisterPlayer.Register", child.Name())
+	assert.Equal(t, codes.Error, child.Status().Code)
+	assert.Equal(t, root.SpanContext().SpanID(), child.Parent().SpanID())
+	assert.Equal(t, codes.Unset, root.Status().Code)
+}